@@ -1,49 +1,207 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"log"
 	"net"
 	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
 
-	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
-	"github.com/liju-github/ContentService/internal/models"
+	"github.com/liju-github/ContentService/internal/auth"
+	"github.com/liju-github/ContentService/internal/config"
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/health"
+	"github.com/liju-github/ContentService/internal/policy"
+	"github.com/liju-github/ContentService/internal/purge"
 	"github.com/liju-github/ContentService/internal/repository"
+	"github.com/liju-github/ContentService/internal/repository/sqlite"
 	"github.com/liju-github/ContentService/internal/service"
 	contentPB "github.com/liju-github/ContentService/proto/content"
+	userPB "github.com/liju-github/ContentService/proto/user"
 )
 
 func main() {
-    if err := godotenv.Load(".env"); err != nil {
-        log.Fatal("Error loading .env file")
+    cfg, err := config.Load(os.Args[1:])
+    if errors.Is(err, flag.ErrHelp) {
+        return
+    }
+    if err != nil {
+        log.Fatalf("Failed to load configuration: %v", err)
+    }
+    // The configuration is printed even when it is invalid, to help find
+    // out why
+    if cfg.PrintConfig {
+        if err := cfg.Print(os.Stdout); err != nil {
+            log.Fatalf("Failed to print configuration: %v", err)
+        }
+    }
+    if err := cfg.Validate(); err != nil {
+        log.Fatalf("Invalid configuration:\n%v", err)
+    }
+    if cfg.PrintConfig {
+        return
+    }
+
+    var repo mongodb.Repository
+    switch cfg.Storage.Backend {
+    case config.BackendMongo:
+        repo, err = mongodb.NewMongoRepository(&cfg.Mongo)
+        if err != nil {
+            log.Fatalf("Failed to connect to MongoDB: %v", err)
+        }
+    case config.BackendSQLite:
+        repo, err = sqlite.New(cfg.Storage.SQLitePath)
+        if err != nil {
+            log.Fatalf("Failed to open SQLite database: %v", err)
+        }
     }
 
-    mongoURI := os.Getenv("MONGO_URI")
-    dbName := os.Getenv("MONGO_DB_NAME")
-    cfg := models.MongoConfig{
-        URI: mongoURI,
-        Database: dbName,
+    tagPolicy, err := service.ParseTagPolicy(cfg.Features.TagPolicy)
+    if err != nil {
+        log.Fatalf("Invalid configuration: %v", err)
     }
-    port := os.Getenv("PORT")
+    contentService := service.NewContentService(repo,
+        service.WithTagPolicy(tagPolicy),
+        service.WithAutoHide(cfg.Moderation.AutoHide),
+        service.WithLimits(service.Limits(cfg.Limits)),
+    )
 
-    repo, err := mongodb.NewMongoRepository(&cfg)
+    userConn, err := grpc.NewClient(cfg.UserService.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
     if err != nil {
-        log.Fatalf("Failed to connect to MongoDB: %v", err)
+        log.Fatalf("Failed to create UserService client: %v", err)
     }
 
-    contentService := service.NewContentService(repo)
+    userClient := userPB.NewUserServiceClient(userConn)
+    authenticator := auth.NewAuthenticator(userClient, auth.WithCacheTTL(cfg.Auth.CacheTTL))
+    banChecker := auth.NewBanChecker(userClient, cfg.Auth.BanCacheTTL)
+
+    roles := policy.StaticRoles{}
+    roles.Assign(policy.RoleModerator, cfg.Auth.ModeratorIDs...)
+    roles.Assign(policy.RoleAdmin, cfg.Auth.AdminIDs...)
+    accessPolicy := policy.New(roles, service.MethodRoles)
+
+    // Background workers get their own context so that they keep running
+    // while in-flight requests drain, and stop before the repository closes.
+    workerCtx, stopWorkers := context.WithCancel(context.Background())
+    var workers sync.WaitGroup
+    if cfg.Features.Purge {
+        workers.Add(1)
+        go func() {
+            defer workers.Done()
+            purge.NewWorker(repo, cfg.Purge.Retention, cfg.Purge.Interval).Run(workerCtx)
+        }()
+    }
 
-    lis, err := net.Listen("tcp", ":"+port)
+    lis, err := net.Listen("tcp", ":"+strconv.Itoa(cfg.Server.Port))
     if err != nil {
         log.Fatalf("Failed to listen: %v", err)
     }
 
-    server := grpc.NewServer()
+    server := grpc.NewServer(
+        grpc.ChainUnaryInterceptor(
+            errs.UnaryServerInterceptor(),
+            authenticator.UnaryServerInterceptor(),
+            accessPolicy.UnaryServerInterceptor(),
+            banChecker.UnaryServerInterceptor(service.WriteMethods...),
+        ),
+        grpc.StreamInterceptor(authenticator.StreamServerInterceptor()),
+    )
     contentPB.RegisterContentServiceServer(server, contentService)
 
-    log.Printf("Server listening on port %s", port)
-    if err := server.Serve(lis); err != nil {
-        log.Fatalf("Failed to serve: %v", err)
+    healthServer := grpchealth.NewServer()
+    healthpb.RegisterHealthServer(server, healthServer)
+
+    if cfg.Features.Reflection {
+        reflection.Register(server)
+    }
+
+    // ContentService cannot answer anything without its database, but
+    // anonymous reads keep working while UserService is unreachable.
+    monitor := health.NewMonitor(healthServer, contentPB.ContentService_ServiceDesc.ServiceName,
+        health.WithInterval(cfg.Health.Interval),
+        health.WithTimeout(cfg.Health.Timeout),
+    )
+    monitor.Require("database", repo.Ping)
+    monitor.Use(userPB.UserService_ServiceDesc.ServiceName, health.ConnCheck(userConn))
+    workers.Add(1)
+    go func() {
+        defer workers.Done()
+        monitor.Run(workerCtx)
+    }()
+
+    ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+    defer stop()
+
+    serveErr := make(chan error, 1)
+    go func() {
+        log.Printf("Server listening on port %d", cfg.Server.Port)
+        serveErr <- server.Serve(lis)
+    }()
+
+    exitCode := 0
+    select {
+    case err := <-serveErr:
+        log.Printf("Failed to serve: %v", err)
+        exitCode = 1
+    case <-ctx.Done():
+        log.Printf("Shutting down")
+    }
+    // A second signal kills the process without waiting for the drain
+    stop()
+
+    // Tell load balancers to stop sending traffic, and give them time to
+    // notice, before draining
+    healthServer.Shutdown()
+    if exitCode == 0 && cfg.Server.DrainDelay > 0 {
+        time.Sleep(cfg.Server.DrainDelay)
+    }
+    gracefulStop(server, cfg.Server.ShutdownTimeout)
+
+    stopWorkers()
+    workers.Wait()
+
+    closeCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+    defer cancel()
+    if err := repo.Close(closeCtx); err != nil {
+        log.Printf("Failed to close repository: %v", err)
+        exitCode = 1
+    }
+    if err := userConn.Close(); err != nil {
+        log.Printf("Failed to close UserService connection: %v", err)
+    }
+
+    log.Printf("Shutdown complete")
+    if exitCode != 0 {
+        os.Exit(exitCode)
+    }
+}
+
+// gracefulStop stops server once its in-flight RPCs have finished, or
+// cancels the ones still running when timeout passes first.
+func gracefulStop(server *grpc.Server, timeout time.Duration) {
+    done := make(chan struct{})
+    go func() {
+        server.GracefulStop()
+        close(done)
+    }()
+
+    select {
+    case <-done:
+    case <-time.After(timeout):
+        log.Printf("In-flight requests did not finish within %v; closing connections", timeout)
+        server.Stop()
+        <-done
     }
 }
\ No newline at end of file
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/liju-github/ContentService/internal/config"
+	"github.com/liju-github/ContentService/internal/repository"
+)
+
+func main() {
+	flags := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	name := flags.String("name", "", "migration to run")
+	list := flags.Bool("list", false, "list available migrations")
+
+	cfg, err := config.LoadFlags(flags, os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	if *list {
+		for _, m := range mongodb.Migrations {
+			fmt.Printf("%-16s %s\n", m.Name, m.Description)
+		}
+		return
+	}
+
+	migration, ok := mongodb.FindMigration(*name)
+	if !ok {
+		log.Fatalf("Unknown migration %q, use -list to see available migrations", *name)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
+	// The SQLite backend migrates its schema when it is opened
+	if cfg.Storage.Backend != config.BackendMongo {
+		log.Fatalf("Migrations only apply to the %s backend, not %s", config.BackendMongo, cfg.Storage.Backend)
+	}
+
+	repo, err := mongodb.NewMongoRepository(&cfg.Mongo)
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+
+	ctx := context.Background()
+	defer repo.Close(ctx)
+
+	count, err := migration.Run(repo, ctx)
+	if err != nil {
+		log.Fatalf("Migration %s failed after %d documents: %v", migration.Name, count, err)
+	}
+
+	log.Printf("Migration %s finished, %d documents updated", migration.Name, count)
+}
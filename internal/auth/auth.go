@@ -0,0 +1,223 @@
+// Package auth identifies the callers of ContentService. Requests carry a
+// bearer token in their "authorization" metadata, which is resolved to a user
+// through UserService and stored in the request context.
+package auth
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	userPB "github.com/liju-github/ContentService/proto/user"
+)
+
+const (
+	defaultCacheTTL = time.Minute
+	// maxCacheEntries bounds the token cache. Expired entries are dropped
+	// when it is full.
+	maxCacheEntries = 10000
+)
+
+// Identity is the authenticated caller of a request.
+type Identity struct {
+	UserID string
+	Email  string
+	Name   string
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx that carries id.
+func NewContext(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the identity stored in ctx, if any.
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(contextKey{}).(Identity)
+	return id, ok
+}
+
+// UserID returns the ID of the authenticated caller, or an Unauthenticated
+// error for anonymous requests.
+func UserID(ctx context.Context) (string, error) {
+	id, ok := FromContext(ctx)
+	if !ok {
+		return "", errs.Unauthenticatedf("authentication required")
+	}
+	return id.UserID, nil
+}
+
+type cacheEntry struct {
+	identity Identity
+	expires  time.Time
+}
+
+// Authenticator resolves bearer tokens through UserService and caches the
+// result for a TTL.
+type Authenticator struct {
+	users userPB.UserServiceClient
+	ttl   time.Duration
+	now   func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// Option configures optional Authenticator behaviour.
+type Option func(*Authenticator)
+
+// WithCacheTTL sets how long a resolved token is trusted before UserService
+// is asked again. A TTL of zero disables caching.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(a *Authenticator) {
+		a.ttl = ttl
+	}
+}
+
+func NewAuthenticator(users userPB.UserServiceClient, opts ...Option) *Authenticator {
+	a := &Authenticator{
+		users: users,
+		ttl:   defaultCacheTTL,
+		now:   time.Now,
+		cache: make(map[string]cacheEntry),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Authenticate adds the caller's identity to ctx. Requests without a token
+// are let through anonymously; handlers that need a caller reject them with
+// UserID. A token that UserService does not accept is an error.
+func (a *Authenticator) Authenticate(ctx context.Context) (context.Context, error) {
+	token, err := bearerToken(ctx)
+	if err != nil || token == "" {
+		return ctx, err
+	}
+
+	id, err := a.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewContext(ctx, id), nil
+}
+
+func (a *Authenticator) resolve(ctx context.Context, token string) (Identity, error) {
+	if id, ok := a.cached(token); ok {
+		return id, nil
+	}
+
+	profile, err := a.users.GetUserByToken(ctx, &userPB.GetUserByTokenRequest{Token: token})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated, codes.NotFound, codes.InvalidArgument, codes.PermissionDenied:
+			return Identity{}, errs.Unauthenticatedf("invalid token")
+		default:
+			return Identity{}, errs.Wrap(errs.Unavailable, err, "user service unavailable")
+		}
+	}
+
+	if profile.UserId == "" {
+		return Identity{}, errs.Unauthenticatedf("invalid token")
+	}
+
+	id := Identity{
+		UserID: profile.UserId,
+		Email:  profile.Email,
+		Name:   profile.Name,
+	}
+	a.store(token, id)
+	return id, nil
+}
+
+func (a *Authenticator) cached(token string) (Identity, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	entry, ok := a.cache[token]
+	if !ok || !a.now().Before(entry.expires) {
+		return Identity{}, false
+	}
+	return entry.identity, true
+}
+
+func (a *Authenticator) store(token string, id Identity) {
+	if a.ttl <= 0 {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	if len(a.cache) >= maxCacheEntries {
+		for key, entry := range a.cache {
+			if !now.Before(entry.expires) {
+				delete(a.cache, key)
+			}
+		}
+	}
+	if len(a.cache) >= maxCacheEntries {
+		return
+	}
+
+	a.cache[token] = cacheEntry{identity: id, expires: now.Add(a.ttl)}
+}
+
+// bearerToken returns the token of an "authorization: Bearer <token>"
+// metadata entry, or "" when there is none.
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || strings.TrimSpace(token) == "" {
+		return "", errs.Unauthenticatedf("malformed authorization header")
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// UnaryServerInterceptor authenticates unary calls.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates streaming calls.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.Authenticate(ss.Context())
+		if err != nil {
+			return errs.ToStatus(err)
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream overrides the context of a grpc.ServerStream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/liju-github/ContentService/internal/errs"
+)
+
+func withToken(header string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", header))
+}
+
+func TestAuthenticateCachesTokens(t *testing.T) {
+	users, client := serveUsers(t)
+	users.AddUser("token", "alice", "Alice")
+
+	c := &clock{t: time.Now()}
+	a := NewAuthenticator(client, WithCacheTTL(time.Minute))
+	a.now = c.now
+
+	for i := 0; i < 3; i++ {
+		ctx, err := a.Authenticate(withToken("Bearer token"))
+		if err != nil {
+			t.Fatalf("Authenticate = %v", err)
+		}
+		if id, ok := FromContext(ctx); !ok || id.UserID != "alice" || id.Name != "Alice" {
+			t.Fatalf("identity = %+v, %v, want alice", id, ok)
+		}
+	}
+	if got := users.Calls("GetUserByToken"); got != 1 {
+		t.Errorf("GetUserByToken called %d times, want 1", got)
+	}
+}
+
+func TestAuthenticateRevokedTokenAfterTTL(t *testing.T) {
+	users, client := serveUsers(t)
+	users.AddUser("token", "alice", "Alice")
+
+	c := &clock{t: time.Now()}
+	a := NewAuthenticator(client, WithCacheTTL(time.Minute))
+	a.now = c.now
+
+	if _, err := a.Authenticate(withToken("Bearer token")); err != nil {
+		t.Fatalf("Authenticate = %v", err)
+	}
+
+	users.RevokeToken("token")
+	if _, err := a.Authenticate(withToken("Bearer token")); err != nil {
+		t.Errorf("revoked token rejected before the TTL expired: %v", err)
+	}
+
+	c.advance(time.Minute)
+	if _, err := a.Authenticate(withToken("Bearer token")); !errs.Is(err, errs.Unauthenticated) {
+		t.Errorf("Authenticate with a revoked token after the TTL = %v, want Unauthenticated", err)
+	}
+}
+
+func TestAuthenticateMalformedHeader(t *testing.T) {
+	users, client := serveUsers(t)
+	a := NewAuthenticator(client)
+
+	for _, header := range []string{"token", "Basic token", "Bearer ", "Bearer"} {
+		if _, err := a.Authenticate(withToken(header)); !errs.Is(err, errs.Unauthenticated) {
+			t.Errorf("Authenticate(%q) = %v, want Unauthenticated", header, err)
+		}
+	}
+	if got := users.Calls("GetUserByToken"); got != 0 {
+		t.Errorf("GetUserByToken called %d times for malformed headers", got)
+	}
+}
+
+func TestAuthenticateAnonymous(t *testing.T) {
+	users, client := serveUsers(t)
+	a := NewAuthenticator(client)
+
+	ctx, err := a.Authenticate(context.Background())
+	if err != nil {
+		t.Fatalf("Authenticate without a token = %v", err)
+	}
+	if _, ok := FromContext(ctx); ok {
+		t.Error("anonymous call has an identity")
+	}
+	if _, err := UserID(ctx); !errs.Is(err, errs.Unauthenticated) {
+		t.Errorf("UserID of an anonymous call = %v, want Unauthenticated", err)
+	}
+	if got := users.Calls("GetUserByToken"); got != 0 {
+		t.Errorf("GetUserByToken called %d times for an anonymous call", got)
+	}
+}
@@ -0,0 +1,123 @@
+// Package authtest provides an in-process fake of UserService for exercising
+// the auth interceptors and the handlers behind them.
+package authtest
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	userPB "github.com/liju-github/ContentService/proto/user"
+)
+
+const bufSize = 1 << 20
+
+// UserService is a fake UserService that knows a fixed set of tokens.
+type UserService struct {
+	userPB.UnimplementedUserServiceServer
+
+	mu     sync.Mutex
+	users  map[string]*userPB.ProfileResponse
+	tokens map[string]string
+	calls  map[string]int
+}
+
+func NewUserService() *UserService {
+	return &UserService{
+		users:  make(map[string]*userPB.ProfileResponse),
+		tokens: make(map[string]string),
+		calls:  make(map[string]int),
+	}
+}
+
+// AddUser registers a user that token resolves to.
+func (f *UserService) AddUser(token, userID, name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.users[userID] = &userPB.ProfileResponse{UserId: userID, Name: name}
+	f.tokens[token] = userID
+}
+
+// RevokeToken makes token unknown to the service.
+func (f *UserService) RevokeToken(token string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.tokens, token)
+}
+
+// SetBanned changes the ban status reported for a user.
+func (f *UserService) SetBanned(userID string, banned bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if user, ok := f.users[userID]; ok {
+		user.IsBanned = banned
+	}
+}
+
+// Calls returns how many times the named RPC was called.
+func (f *UserService) Calls(method string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls[method]
+}
+
+func (f *UserService) GetUserByToken(ctx context.Context, req *userPB.GetUserByTokenRequest) (*userPB.ProfileResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls["GetUserByToken"]++
+	userID, ok := f.tokens[req.Token]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return proto.Clone(f.users[userID]).(*userPB.ProfileResponse), nil
+}
+
+func (f *UserService) CheckBan(ctx context.Context, req *userPB.CheckBanRequest) (*userPB.CheckBanResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls["CheckBan"]++
+	user, ok := f.users[req.UserID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &userPB.CheckBanResponse{UserID: user.UserId, BanStatus: user.IsBanned}, nil
+}
+
+// Serve starts f on an in-memory listener and returns a client connected to
+// it. The returned function stops the server and closes the client.
+func Serve(f *UserService) (userPB.UserServiceClient, func(), error) {
+	lis := bufconn.Listen(bufSize)
+	server := grpc.NewServer()
+	userPB.RegisterUserServiceServer(server, f)
+	go server.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		server.Stop()
+		return nil, nil, err
+	}
+
+	stop := func() {
+		conn.Close()
+		server.Stop()
+	}
+	return userPB.NewUserServiceClient(conn), stop, nil
+}
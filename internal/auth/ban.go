@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	userPB "github.com/liju-github/ContentService/proto/user"
+)
+
+type banEntry struct {
+	banned  bool
+	expires time.Time
+}
+
+// BanChecker asks UserService whether users are banned. Answers are cached
+// for a TTL, which bounds how long a ban or unban takes to apply.
+type BanChecker struct {
+	users userPB.UserServiceClient
+	ttl   time.Duration
+	now   func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]banEntry
+}
+
+// NewBanChecker returns a BanChecker that caches ban status for ttl. Like
+// WithCacheTTL for the Authenticator, a ttl of zero disables caching.
+func NewBanChecker(users userPB.UserServiceClient, ttl time.Duration) *BanChecker {
+	return &BanChecker{
+		users: users,
+		ttl:   ttl,
+		now:   time.Now,
+		cache: make(map[string]banEntry),
+	}
+}
+
+// CheckNotBanned returns a PermissionDenied error if the user is banned. It
+// fails closed: if UserService cannot be reached the error is Unavailable,
+// and any other error from UserService is returned as it is.
+func (b *BanChecker) CheckNotBanned(ctx context.Context, userID string) error {
+	banned, err := b.isBanned(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if banned {
+		return errs.PermissionDeniedf("user is banned")
+	}
+	return nil
+}
+
+func (b *BanChecker) isBanned(ctx context.Context, userID string) (bool, error) {
+	b.mu.Lock()
+	entry, ok := b.cache[userID]
+	b.mu.Unlock()
+	if ok && b.now().Before(entry.expires) {
+		return entry.banned, nil
+	}
+
+	resp, err := b.users.CheckBan(ctx, &userPB.CheckBanRequest{UserID: userID})
+	if err != nil {
+		// Only a failure to reach UserService is an outage; its answers,
+		// such as NotFound for an unknown user, are passed on
+		switch status.Code(err) {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted, codes.Unknown:
+			return false, errs.Wrap(errs.Unavailable, err, "failed to check ban status")
+		default:
+			return false, err
+		}
+	}
+
+	if b.ttl > 0 {
+		b.mu.Lock()
+		now := b.now()
+		if len(b.cache) >= maxCacheEntries {
+			for key, entry := range b.cache {
+				if !now.Before(entry.expires) {
+					delete(b.cache, key)
+				}
+			}
+		}
+		if len(b.cache) < maxCacheEntries {
+			b.cache[userID] = banEntry{banned: resp.BanStatus, expires: now.Add(b.ttl)}
+		}
+		b.mu.Unlock()
+	}
+
+	return resp.BanStatus, nil
+}
+
+// UnaryServerInterceptor rejects calls to the given methods from banned
+// users. It must run after the Authenticator's interceptor. Anonymous calls
+// are passed on for the handler to reject.
+func (b *BanChecker) UnaryServerInterceptor(methods ...string) grpc.UnaryServerInterceptor {
+	checked := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		checked[method] = true
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if checked[info.FullMethod] {
+			if id, ok := FromContext(ctx); ok {
+				if err := b.CheckNotBanned(ctx, id.UserID); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liju-github/ContentService/internal/auth/authtest"
+	"github.com/liju-github/ContentService/internal/errs"
+	userPB "github.com/liju-github/ContentService/proto/user"
+)
+
+// clock is a settable time source for the caches.
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time          { return c.t }
+func (c *clock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func serveUsers(t *testing.T) (*authtest.UserService, userPB.UserServiceClient) {
+	t.Helper()
+
+	users := authtest.NewUserService()
+	client, stop, err := authtest.Serve(users)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(stop)
+	return users, client
+}
+
+func TestBanAppliesAfterTTL(t *testing.T) {
+	users, client := serveUsers(t)
+	users.AddUser("token", "alice", "Alice")
+
+	c := &clock{t: time.Now()}
+	checker := NewBanChecker(client, time.Minute)
+	checker.now = c.now
+
+	ctx := context.Background()
+	if err := checker.CheckNotBanned(ctx, "alice"); err != nil {
+		t.Fatalf("CheckNotBanned before the ban = %v", err)
+	}
+
+	users.SetBanned("alice", true)
+	if err := checker.CheckNotBanned(ctx, "alice"); err != nil {
+		t.Errorf("ban applied before the TTL expired: %v", err)
+	}
+
+	c.advance(time.Minute)
+	if err := checker.CheckNotBanned(ctx, "alice"); !errs.Is(err, errs.PermissionDenied) {
+		t.Errorf("CheckNotBanned after the TTL = %v, want PermissionDenied", err)
+	}
+
+	users.SetBanned("alice", false)
+	c.advance(time.Minute)
+	if err := checker.CheckNotBanned(ctx, "alice"); err != nil {
+		t.Errorf("unban did not apply after the TTL: %v", err)
+	}
+
+	if got := users.Calls("CheckBan"); got != 3 {
+		t.Errorf("CheckBan called %d times, want 3", got)
+	}
+}
+
+func TestBanCacheDisabled(t *testing.T) {
+	users, client := serveUsers(t)
+	users.AddUser("token", "alice", "Alice")
+
+	checker := NewBanChecker(client, 0)
+	ctx := context.Background()
+	if err := checker.CheckNotBanned(ctx, "alice"); err != nil {
+		t.Fatalf("CheckNotBanned before the ban = %v", err)
+	}
+
+	users.SetBanned("alice", true)
+	if err := checker.CheckNotBanned(ctx, "alice"); !errs.Is(err, errs.PermissionDenied) {
+		t.Errorf("CheckNotBanned with caching disabled = %v, want PermissionDenied", err)
+	}
+	if got := users.Calls("CheckBan"); got != 2 {
+		t.Errorf("CheckBan called %d times, want 2", got)
+	}
+}
+
+func TestBanCheckPassesOnUserServiceErrors(t *testing.T) {
+	_, client := serveUsers(t)
+
+	err := NewBanChecker(client, 0).CheckNotBanned(context.Background(), "nobody")
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("CheckNotBanned for an unknown user = %v, want NotFound", err)
+	}
+}
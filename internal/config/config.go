@@ -0,0 +1,194 @@
+// Package config holds the settings of ContentService. Each setting has a
+// default that a JSON file, then an environment variable, then a command-line
+// flag may override, and the result is checked by Validate before the server
+// uses it. The settings are plain values; main turns them into the options
+// of the packages they configure.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// Storage backends.
+const (
+	BackendMongo  = "mongo"
+	BackendSQLite = "sqlite"
+)
+
+type Config struct {
+	Server      Server
+	Storage     Storage
+	Mongo       models.MongoConfig
+	UserService UserService
+	Auth        Auth
+	Limits      models.Limits
+	Moderation  Moderation
+	Purge       Purge
+	Health      Health
+	Features    Features
+
+	// File is the JSON file the configuration was read from, if any.
+	File string
+	// PrintConfig asks for the configuration to be printed instead of
+	// starting the server.
+	PrintConfig bool
+}
+
+type Server struct {
+	Port int
+	// DrainDelay is how long the server keeps serving after reporting
+	// NOT_SERVING on shutdown, so that load balancers notice before
+	// connections are closed.
+	DrainDelay time.Duration
+	// ShutdownTimeout bounds how long in-flight requests may take to finish
+	// on shutdown, and then how long closing the repository may take.
+	ShutdownTimeout time.Duration
+}
+
+type Storage struct {
+	Backend    string
+	SQLitePath string
+}
+
+type UserService struct {
+	Addr string
+}
+
+type Auth struct {
+	// CacheTTL is how long a resolved token is trusted. Zero disables
+	// caching.
+	CacheTTL time.Duration
+	// BanCacheTTL is how long a user's ban status is cached. Zero disables
+	// caching.
+	BanCacheTTL  time.Duration
+	ModeratorIDs []string
+	AdminIDs     []string
+}
+
+type Moderation struct {
+	AutoHide models.AutoHide
+}
+
+type Purge struct {
+	Retention time.Duration
+	Interval  time.Duration
+}
+
+type Health struct {
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// Features toggles optional behaviour.
+type Features struct {
+	// TagPolicy is how tags missing from the catalog are treated: one of
+	// the models.TagPolicy constants.
+	TagPolicy  string
+	Reflection bool
+	// Purge runs the worker that hard-deletes soft-deleted content.
+	Purge bool
+}
+
+// Default returns the configuration used when nothing overrides it.
+func Default() *Config {
+	return &Config{
+		Server: Server{
+			Port:            50052,
+			DrainDelay:      5 * time.Second,
+			ShutdownTimeout: 20 * time.Second,
+		},
+		Storage: Storage{
+			Backend:    BackendMongo,
+			SQLitePath: "content.db",
+		},
+		Mongo: models.MongoConfig{
+			URI:                    "mongodb://localhost:27017",
+			Database:               "content",
+			MaxPoolSize:            100,
+			ConnectTimeout:         10 * time.Second,
+			ServerSelectionTimeout: 30 * time.Second,
+		},
+		UserService: UserService{
+			Addr: "localhost:50051",
+		},
+		Auth: Auth{
+			CacheTTL:    time.Minute,
+			BanCacheTTL: 30 * time.Second,
+		},
+		Limits: models.DefaultLimits,
+		Moderation: Moderation{
+			AutoHide: models.DefaultAutoHide,
+		},
+		Purge: Purge{
+			Retention: models.DefaultPurgeRetention,
+			Interval:  models.DefaultPurgeInterval,
+		},
+		Health: Health{
+			Interval: 10 * time.Second,
+			Timeout:  2 * time.Second,
+		},
+		Features: Features{
+			TagPolicy: models.TagPolicyAllow,
+			Purge:     true,
+		},
+	}
+}
+
+// Validate reports every setting that has an unusable value.
+func (c *Config) Validate() error {
+	var problems []error
+	check := func(ok bool, name, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Errorf("%s: "+format, append([]any{name}, args...)...))
+		}
+	}
+
+	check(c.Server.Port > 0 && c.Server.Port <= 65535, "server.port", "must be between 1 and 65535")
+	check(c.Server.DrainDelay >= 0, "server.drain-delay", "must not be negative")
+	check(c.Server.ShutdownTimeout > 0, "server.shutdown-timeout", "must be positive")
+
+	switch c.Storage.Backend {
+	case BackendMongo:
+		check(c.Mongo.URI != "", "mongo.uri", "is required")
+		check(c.Mongo.Database != "", "mongo.database", "is required")
+	case BackendSQLite:
+		check(c.Storage.SQLitePath != "", "storage.sqlite-path", "is required")
+	default:
+		check(false, "storage.backend", "must be %s or %s", BackendMongo, BackendSQLite)
+	}
+	check(c.Mongo.MaxPoolSize == 0 || c.Mongo.MinPoolSize <= c.Mongo.MaxPoolSize, "mongo.min-pool-size", "must not exceed mongo.max-pool-size")
+	check(c.Mongo.MaxConnIdleTime >= 0, "mongo.max-conn-idle-time", "must not be negative")
+	check(c.Mongo.ConnectTimeout >= 0, "mongo.connect-timeout", "must not be negative")
+	check(c.Mongo.ServerSelectionTimeout >= 0, "mongo.server-selection-timeout", "must not be negative")
+
+	check(c.UserService.Addr != "", "user-service.addr", "is required")
+	check(c.Auth.CacheTTL >= 0, "auth.cache-ttl", "must not be negative")
+	check(c.Auth.BanCacheTTL >= 0, "auth.ban-cache-ttl", "must not be negative")
+
+	check(c.Limits.MinQuestionLength > 0, "limits.min-question-length", "must be positive")
+	check(c.Limits.MaxDetailsLength > 0, "limits.max-details-length", "must be positive")
+	check(c.Limits.MinAnswerLength > 0, "limits.min-answer-length", "must be positive")
+	check(c.Limits.MaxAnswerLength >= c.Limits.MinAnswerLength, "limits.max-answer-length", "must not be less than limits.min-answer-length")
+	check(c.Limits.MaxTags > 0, "limits.max-tags", "must be positive")
+
+	check(c.Moderation.AutoHide.Flags >= 0, "moderation.flag-hide-threshold", "must not be negative")
+	check(c.Moderation.AutoHide.Score >= 0, "moderation.flag-hide-score", "must not be negative")
+
+	check(c.Purge.Retention > 0, "purge.retention", "must be positive")
+	check(c.Purge.Interval > 0, "purge.interval", "must be positive")
+	check(c.Health.Interval > 0, "health.interval", "must be positive")
+	check(c.Health.Timeout > 0, "health.timeout", "must be positive")
+
+	switch strings.ToLower(strings.TrimSpace(c.Features.TagPolicy)) {
+	case models.TagPolicyAllow, models.TagPolicyReject, models.TagPolicyCreate:
+	default:
+		check(false, "features.tag-policy", "must be %s, %s or %s", models.TagPolicyAllow, models.TagPolicyReject, models.TagPolicyCreate)
+	}
+
+	return errors.Join(problems...)
+}
@@ -0,0 +1,340 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// setting is one configurable value. Its name is both its command-line flag
+// and its key in the config file, where the part before the dot names the
+// section it is nested in.
+type setting struct {
+	name  string
+	env   string
+	usage string
+	field func(c *Config) any
+}
+
+var settings = []setting{
+	{"server.port", "PORT", "TCP port to serve gRPC on", func(c *Config) any { return &c.Server.Port }},
+	{"server.drain-delay", "SHUTDOWN_DRAIN_DELAY", "how long to keep serving after reporting NOT_SERVING on shutdown", func(c *Config) any { return &c.Server.DrainDelay }},
+	{"server.shutdown-timeout", "SHUTDOWN_TIMEOUT", "how long to wait for in-flight requests, then for the repository to close, on shutdown", func(c *Config) any { return &c.Server.ShutdownTimeout }},
+
+	{"storage.backend", "STORAGE_BACKEND", "repository to store content in: mongo or sqlite", func(c *Config) any { return &c.Storage.Backend }},
+	{"storage.sqlite-path", "SQLITE_PATH", "database file of the sqlite backend", func(c *Config) any { return &c.Storage.SQLitePath }},
+
+	{"mongo.uri", "MONGO_URI", "MongoDB connection string", func(c *Config) any { return &c.Mongo.URI }},
+	{"mongo.database", "MONGO_DB_NAME", "MongoDB database name", func(c *Config) any { return &c.Mongo.Database }},
+	{"mongo.max-pool-size", "MONGO_MAX_POOL_SIZE", "maximum connections per server, 0 for no limit", func(c *Config) any { return &c.Mongo.MaxPoolSize }},
+	{"mongo.min-pool-size", "MONGO_MIN_POOL_SIZE", "connections per server to keep open", func(c *Config) any { return &c.Mongo.MinPoolSize }},
+	{"mongo.max-conn-idle-time", "MONGO_MAX_CONN_IDLE_TIME", "how long an idle connection is kept, 0 for no limit", func(c *Config) any { return &c.Mongo.MaxConnIdleTime }},
+	{"mongo.connect-timeout", "MONGO_CONNECT_TIMEOUT", "timeout for connecting to MongoDB and preparing indexes at startup", func(c *Config) any { return &c.Mongo.ConnectTimeout }},
+	{"mongo.server-selection-timeout", "MONGO_SERVER_SELECTION_TIMEOUT", "how long an operation waits for a suitable server", func(c *Config) any { return &c.Mongo.ServerSelectionTimeout }},
+
+	{"user-service.addr", "USER_SERVICE_ADDR", "address of UserService", func(c *Config) any { return &c.UserService.Addr }},
+
+	{"auth.cache-ttl", "AUTH_CACHE_TTL", "how long a resolved token is trusted, 0 to disable caching", func(c *Config) any { return &c.Auth.CacheTTL }},
+	{"auth.ban-cache-ttl", "BAN_CACHE_TTL", "how long ban status is cached, 0 to disable caching", func(c *Config) any { return &c.Auth.BanCacheTTL }},
+	{"auth.moderators", "MODERATOR_USER_IDS", "comma-separated IDs of moderators", func(c *Config) any { return &c.Auth.ModeratorIDs }},
+	{"auth.admins", "ADMIN_USER_IDS", "comma-separated IDs of admins", func(c *Config) any { return &c.Auth.AdminIDs }},
+
+	{"limits.min-question-length", "MIN_QUESTION_LENGTH", "minimum length of a question", func(c *Config) any { return &c.Limits.MinQuestionLength }},
+	{"limits.max-details-length", "MAX_DETAILS_LENGTH", "maximum length of a question's details", func(c *Config) any { return &c.Limits.MaxDetailsLength }},
+	{"limits.min-answer-length", "MIN_ANSWER_LENGTH", "minimum length of an answer", func(c *Config) any { return &c.Limits.MinAnswerLength }},
+	{"limits.max-answer-length", "MAX_ANSWER_LENGTH", "maximum length of an answer", func(c *Config) any { return &c.Limits.MaxAnswerLength }},
+	{"limits.max-tags", "MAX_TAGS", "maximum number of tags on a question", func(c *Config) any { return &c.Limits.MaxTags }},
+
+	{"moderation.flag-hide-threshold", "FLAG_HIDE_THRESHOLD", "open flags that hide a post, 0 to never hide by count", func(c *Config) any { return &c.Moderation.AutoHide.Flags }},
+	{"moderation.flag-hide-score", "FLAG_HIDE_SCORE", "weighted flag score that hides a post, 0 to never hide by score", func(c *Config) any { return &c.Moderation.AutoHide.Score }},
+
+	{"purge.retention", "PURGE_RETENTION", "how long deleted content is kept before it is purged", func(c *Config) any { return &c.Purge.Retention }},
+	{"purge.interval", "PURGE_INTERVAL", "how often deleted content is purged", func(c *Config) any { return &c.Purge.Interval }},
+
+	{"health.interval", "HEALTH_CHECK_INTERVAL", "how often dependencies are checked", func(c *Config) any { return &c.Health.Interval }},
+	{"health.timeout", "HEALTH_CHECK_TIMEOUT", "timeout of each dependency check", func(c *Config) any { return &c.Health.Timeout }},
+
+	{"features.tag-policy", "TAG_POLICY", "how tags missing from the catalog are treated: allow, reject or create", func(c *Config) any { return &c.Features.TagPolicy }},
+	{"features.reflection", "GRPC_REFLECTION", "register the gRPC reflection service", func(c *Config) any { return &c.Features.Reflection }},
+	{"features.purge", "PURGE_ENABLED", "purge deleted content in the background", func(c *Config) any { return &c.Features.Purge }},
+}
+
+func lookup(name string) (setting, bool) {
+	for _, s := range settings {
+		if s.name == name {
+			return s, true
+		}
+	}
+	return setting{}, false
+}
+
+// Load builds the configuration from, in increasing precedence, the
+// defaults, the JSON file named by -config or CONFIG_FILE, the environment
+// and the command-line flags in args. A .env file in the working directory
+// is added to the environment when there is one. Load does not validate the
+// result.
+func Load(args []string) (*Config, error) {
+	return LoadFlags(flag.NewFlagSet(os.Args[0], flag.ContinueOnError), args)
+}
+
+// LoadFlags is Load for a command with flags of its own. The settings are
+// added to flags, which then parses args.
+func LoadFlags(flags *flag.FlagSet, args []string) (*Config, error) {
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("loading .env: %w", err)
+	}
+
+	defaults := Default()
+	file := flags.String("config", os.Getenv("CONFIG_FILE"), "JSON config file (env CONFIG_FILE)")
+	printConfig := flags.Bool("print-config", false, "print the configuration and exit")
+
+	// Flags are applied last, so their values are kept until the file and
+	// the environment have been read
+	type flagValue struct {
+		setting setting
+		raw     string
+	}
+	var flagValues []flagValue
+	for _, s := range settings {
+		usage := fmt.Sprintf("%s (env %s, default %s)", s.usage, s.env, format(s.field(defaults)))
+		record := func(raw string) error {
+			flagValues = append(flagValues, flagValue{s, raw})
+			return nil
+		}
+		if _, ok := s.field(defaults).(*bool); ok {
+			flags.BoolFunc(s.name, usage, record)
+		} else {
+			flags.Func(s.name, usage, record)
+		}
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected argument %q", flags.Arg(0))
+	}
+
+	cfg := defaults
+	cfg.File = *file
+	cfg.PrintConfig = *printConfig
+
+	if cfg.File != "" {
+		if err := cfg.loadFile(cfg.File); err != nil {
+			return nil, fmt.Errorf("loading %s: %w", cfg.File, err)
+		}
+	}
+
+	for _, s := range settings {
+		if raw := os.Getenv(s.env); raw != "" {
+			if err := parse(s.field(cfg), raw); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", s.env, err)
+			}
+		}
+	}
+
+	for _, v := range flagValues {
+		if err := parse(v.setting.field(cfg), v.raw); err != nil {
+			return nil, fmt.Errorf("invalid -%s: %w", v.setting.name, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// loadFile reads a JSON object of sections, each an object of settings, as
+// written by Print.
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var sections map[string]map[string]any
+	if err := decoder.Decode(&sections); err != nil {
+		return err
+	}
+
+	for section, values := range sections {
+		for key, v := range values {
+			name := section + "." + key
+			s, ok := lookup(name)
+			if !ok {
+				return fmt.Errorf("unknown setting %s", name)
+			}
+
+			raw, err := fileValue(v)
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			if err := parse(s.field(c), raw); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// fileValue turns a JSON value into the text its setting is parsed from.
+// Lists become comma-separated.
+func fileValue(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return "", errors.New("list items must be strings")
+			}
+			items[i] = s
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v", value)
+	}
+}
+
+// parse sets the field that ptr points to from raw.
+func parse(ptr any, raw string) error {
+	raw = strings.TrimSpace(raw)
+
+	var err error
+	switch p := ptr.(type) {
+	case *string:
+		*p = raw
+	case *int:
+		*p, err = strconv.Atoi(raw)
+	case *uint64:
+		*p, err = strconv.ParseUint(raw, 10, 64)
+	case *float64:
+		*p, err = strconv.ParseFloat(raw, 64)
+	case *bool:
+		*p, err = strconv.ParseBool(raw)
+	case *time.Duration:
+		*p, err = time.ParseDuration(raw)
+	case *[]string:
+		*p = nil
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*p = append(*p, item)
+			}
+		}
+	default:
+		panic(fmt.Sprintf("config: unsupported setting type %T", ptr))
+	}
+
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		// The caller names the setting, which is clearer than the parse
+		// function the error would otherwise name
+		err = fmt.Errorf("%q: %w", raw, numErr.Err)
+	}
+	return err
+}
+
+// value returns the field that ptr points to in the form Print writes it.
+func value(ptr any) any {
+	switch p := ptr.(type) {
+	case *string:
+		return *p
+	case *int:
+		return *p
+	case *uint64:
+		return *p
+	case *float64:
+		return *p
+	case *bool:
+		return *p
+	case *time.Duration:
+		return p.String()
+	case *[]string:
+		if *p == nil {
+			return []string{}
+		}
+		return *p
+	default:
+		panic(fmt.Sprintf("config: unsupported setting type %T", ptr))
+	}
+}
+
+// format returns the field that ptr points to as flag usage shows it.
+func format(ptr any) string {
+	switch v := value(ptr).(type) {
+	case string:
+		if v == "" {
+			return `""`
+		}
+		return v
+	case []string:
+		if len(v) == 0 {
+			return "none"
+		}
+		return strings.Join(v, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Print writes c as a JSON config file. Passwords in the MongoDB URI are
+// masked.
+func (c *Config) Print(w io.Writer) error {
+	sections := make(map[string]map[string]any)
+	for _, s := range settings {
+		section, key, _ := strings.Cut(s.name, ".")
+		if sections[section] == nil {
+			sections[section] = make(map[string]any)
+		}
+		sections[section][key] = value(s.field(c))
+	}
+	sections["mongo"]["uri"] = redactURI(c.Mongo.URI)
+
+	data, err := json.MarshalIndent(sections, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+// redactURI masks the password in a connection string. It does not use
+// net/url, which rejects the comma-separated host lists of replica sets.
+func redactURI(uri string) string {
+	scheme, rest, ok := strings.Cut(uri, "://")
+	if !ok {
+		return uri
+	}
+
+	authority, path, hasPath := strings.Cut(rest, "/")
+	at := strings.LastIndex(authority, "@")
+	if at < 0 {
+		return uri
+	}
+	userinfo, hosts := authority[:at], authority[at+1:]
+	user, _, ok := strings.Cut(userinfo, ":")
+	if !ok {
+		return uri
+	}
+
+	redacted := scheme + "://" + user + ":xxxxx@" + hosts
+	if hasPath {
+		redacted += "/" + path
+	}
+	return redacted
+}
@@ -0,0 +1,101 @@
+// Package diff produces line-based diffs between two versions of a text.
+package diff
+
+import "strings"
+
+// maxTableCells bounds the size of the table used to find the longest common
+// subsequence, which takes memory proportional to the product of the number
+// of lines that differ in each text. Beyond it the changed lines are shown as
+// removed and re-added in full.
+const maxTableCells = 1 << 20
+
+// Lines returns a diff of a and b with one line per input line, prefixed by
+// "-" for removed lines, "+" for added lines and " " for unchanged lines.
+// It returns an empty string when the texts are equal.
+func Lines(a, b string) string {
+	if a == b {
+		return ""
+	}
+
+	from, to := splitLines(a), splitLines(b)
+
+	// Lines shared at the start and end need no table
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix && from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+
+	var out strings.Builder
+	for _, line := range from[:prefix] {
+		out.WriteString(" " + line + "\n")
+	}
+	changedFrom, changedTo := from[prefix:len(from)-suffix], to[prefix:len(to)-suffix]
+	if len(changedFrom)*len(changedTo) > maxTableCells {
+		replace(&out, changedFrom, changedTo)
+	} else {
+		lcsDiff(&out, changedFrom, changedTo)
+	}
+	for _, line := range from[len(from)-suffix:] {
+		out.WriteString(" " + line + "\n")
+	}
+
+	return out.String()
+}
+
+// lcsDiff writes the shortest diff of from and to, found through their
+// longest common subsequence.
+func lcsDiff(out *strings.Builder, from, to []string) {
+	// lcs[i][j] is the length of the longest common subsequence of from[i:]
+	// and to[j:]
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(from) || j < len(to) {
+		switch {
+		case i < len(from) && j < len(to) && from[i] == to[j]:
+			out.WriteString(" " + from[i] + "\n")
+			i++
+			j++
+		case i < len(from) && (j == len(to) || lcs[i+1][j] >= lcs[i][j+1]):
+			out.WriteString("-" + from[i] + "\n")
+			i++
+		default:
+			out.WriteString("+" + to[j] + "\n")
+			j++
+		}
+	}
+}
+
+// replace writes a diff that removes every line of from and adds every line
+// of to.
+func replace(out *strings.Builder, from, to []string) {
+	for _, line := range from {
+		out.WriteString("-" + line + "\n")
+	}
+	for _, line := range to {
+		out.WriteString("+" + line + "\n")
+	}
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
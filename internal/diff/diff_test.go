@@ -0,0 +1,63 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"equal", "a\nb", "a\nb", ""},
+		{"added", "", "a\nb", "+a\n+b\n"},
+		{"removed", "a\nb", "", "-a\n-b\n"},
+		{"changed middle", "a\nb\nc", "a\nx\nc", " a\n-b\n+x\n c\n"},
+		{"inserted", "a\nc", "a\nb\nc", " a\n+b\n c\n"},
+		{"moved", "a\nb\nc", "b\nc\na", "-a\n b\n c\n+a\n"},
+		{"trailing newline", "a\n", "a\nb\n", " a\n+b\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Lines(tt.a, tt.b); got != tt.want {
+				t.Errorf("Lines(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinesLargeInputs(t *testing.T) {
+	numbered := func(prefix string, n int) []string {
+		lines := make([]string, n)
+		for i := range lines {
+			lines[i] = fmt.Sprintf("%s %d", prefix, i)
+		}
+		return lines
+	}
+
+	// Too many changed lines for the table: they are replaced in full, but
+	// the shared first and last lines are still kept
+	from := append(append([]string{"start"}, numbered("old", 2000)...), "end")
+	to := append(append([]string{"start"}, numbered("new", 2000)...), "end")
+	got := Lines(strings.Join(from, "\n"), strings.Join(to, "\n"))
+
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	if len(lines) != 4002 {
+		t.Fatalf("diff has %d lines, want 4002", len(lines))
+	}
+	if lines[0] != " start" || lines[1] != "-old 0" || lines[2001] != "+new 0" || lines[4001] != " end" {
+		t.Errorf("unexpected diff: %q ... %q", lines[:2], lines[len(lines)-2:])
+	}
+
+	// The table would take a row per changed line
+	allocs := testing.AllocsPerRun(1, func() {
+		Lines(strings.Join(from, "\n"), strings.Join(to, "\n"))
+	})
+	if allocs > 100 {
+		t.Errorf("diff made %v allocations", allocs)
+	}
+}
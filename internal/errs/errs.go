@@ -0,0 +1,240 @@
+// Package errs defines the errors shared by the repository and service
+// layers. Each error has a Kind that decides the gRPC status code it is sent
+// to clients with, and validation errors list the offending request fields.
+package errs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Kind int
+
+const (
+	// Internal is used for errors that the client cannot do anything about.
+	// Their message is not sent to clients.
+	Internal Kind = iota
+	NotFound
+	InvalidArgument
+	PermissionDenied
+	AlreadyExists
+	Unavailable
+	Unauthenticated
+	// FailedPrecondition is used when a request is valid but the state of
+	// the resource it names does not allow it.
+	FailedPrecondition
+)
+
+func (k Kind) String() string {
+	return k.Code().String()
+}
+
+// Code returns the gRPC status code for errors of this kind.
+func (k Kind) Code() codes.Code {
+	switch k {
+	case NotFound:
+		return codes.NotFound
+	case InvalidArgument:
+		return codes.InvalidArgument
+	case PermissionDenied:
+		return codes.PermissionDenied
+	case AlreadyExists:
+		return codes.AlreadyExists
+	case Unavailable:
+		return codes.Unavailable
+	case Unauthenticated:
+		return codes.Unauthenticated
+	case FailedPrecondition:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// FieldViolation describes why a request field is invalid.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+type Error struct {
+	Kind       Kind
+	Message    string
+	Violations []FieldViolation
+	Err        error
+}
+
+func (e *Error) Error() string {
+	switch {
+	case e.Err == nil:
+		return e.Message
+	case e.Message == "":
+		return e.Err.Error()
+	default:
+		return e.Message + ": " + e.Err.Error()
+	}
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus lets grpc-go send the error with its status code. Only Message
+// is sent; the wrapped cause may describe internals and is left for the
+// server to log. Field violations are attached as a google.rpc.BadRequest
+// detail.
+func (e *Error) GRPCStatus() *status.Status {
+	if e.Kind == Internal {
+		return status.New(codes.Internal, "internal error")
+	}
+
+	message := e.Message
+	if message == "" {
+		message = e.Kind.String()
+	}
+
+	st := status.New(e.Kind.Code(), message)
+	if len(e.Violations) == 0 {
+		return st
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range e.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	if detailed, err := st.WithDetails(badRequest); err == nil {
+		return detailed
+	}
+	return st
+}
+
+func New(kind Kind, message string) error {
+	return &Error{Kind: kind, Message: message}
+}
+
+// Wrap returns err as an error of the given kind. Errors that already have a
+// kind are returned unchanged.
+func Wrap(kind Kind, err error, message string) error {
+	if err == nil {
+		return nil
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+
+	return &Error{Kind: kind, Message: message, Err: err}
+}
+
+func NotFoundf(format string, args ...any) error {
+	return &Error{Kind: NotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+func PermissionDeniedf(format string, args ...any) error {
+	return &Error{Kind: PermissionDenied, Message: fmt.Sprintf(format, args...)}
+}
+
+func AlreadyExistsf(format string, args ...any) error {
+	return &Error{Kind: AlreadyExists, Message: fmt.Sprintf(format, args...)}
+}
+
+func Unauthenticatedf(format string, args ...any) error {
+	return &Error{Kind: Unauthenticated, Message: fmt.Sprintf(format, args...)}
+}
+
+func FailedPreconditionf(format string, args ...any) error {
+	return &Error{Kind: FailedPrecondition, Message: fmt.Sprintf(format, args...)}
+}
+
+// Invalidf returns an InvalidArgument error for a request that is invalid as
+// a whole rather than because of one field.
+func Invalidf(format string, args ...any) error {
+	return &Error{Kind: InvalidArgument, Message: fmt.Sprintf(format, args...)}
+}
+
+// InvalidField returns an error for a single invalid request field.
+func InvalidField(field, description string) error {
+	return Validation(FieldViolation{Field: field, Description: description})
+}
+
+// Validation returns an InvalidArgument error listing every invalid field.
+func Validation(violations ...FieldViolation) error {
+	messages := make([]string, len(violations))
+	for i, v := range violations {
+		messages[i] = v.Field + ": " + v.Description
+	}
+
+	return &Error{
+		Kind:       InvalidArgument,
+		Message:    strings.Join(messages, "; "),
+		Violations: violations,
+	}
+}
+
+// KindOf returns the kind of err, or Internal when it has none.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return Internal
+}
+
+func Is(err error, kind Kind) bool {
+	return err != nil && KindOf(err) == kind
+}
+
+// FromDriver gives an error returned by a database driver a kind. Errors that
+// already have one, and context errors, are returned unchanged. classify
+// reports the kind and message of the driver errors it recognises; anything
+// else is Internal.
+func FromDriver(err error, classify func(error) (Kind, string, bool)) error {
+	switch {
+	case err == nil:
+		return nil
+	case KindOf(err) != Internal:
+		return err
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return err
+	}
+
+	if kind, message, ok := classify(err); ok {
+		return Wrap(kind, err, message)
+	}
+	return Wrap(Internal, err, "database error")
+}
+
+// ToStatus converts any error returned by a handler into a gRPC status error.
+// Errors from this package keep their kind, context errors become Canceled or
+// DeadlineExceeded and anything else is reported as Internal.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return e.GRPCStatus().Err()
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,31 @@
+package errs
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusHidesCause(t *testing.T) {
+	cause := errors.New("dial tcp 10.0.0.7:27017: connection refused")
+
+	tests := []struct {
+		err         error
+		wantCode    codes.Code
+		wantMessage string
+	}{
+		{Wrap(Unavailable, cause, "database unavailable"), codes.Unavailable, "database unavailable"},
+		{Wrap(AlreadyExists, cause, ""), codes.AlreadyExists, "AlreadyExists"},
+		{Wrap(Internal, cause, "database error"), codes.Internal, "internal error"},
+		{cause, codes.Internal, "internal error"},
+		{NotFoundf("question not found"), codes.NotFound, "question not found"},
+	}
+	for _, tt := range tests {
+		st, _ := status.FromError(ToStatus(tt.err))
+		if st.Code() != tt.wantCode || st.Message() != tt.wantMessage {
+			t.Errorf("ToStatus(%v) = %v %q, want %v %q", tt.err, st.Code(), st.Message(), tt.wantCode, tt.wantMessage)
+		}
+	}
+}
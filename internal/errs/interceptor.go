@@ -0,0 +1,30 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor converts handler errors with ToStatus. Internal
+// errors and errors that wrap a cause are logged, since the client is only
+// sent their message.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		converted := ToStatus(err)
+		var e *Error
+		if status.Code(converted) == codes.Internal || (errors.As(err, &e) && e.Err != nil) {
+			log.Printf("%s: %v", info.FullMethod, err)
+		}
+		return nil, converted
+	}
+}
@@ -0,0 +1,187 @@
+// Package health publishes the health of ContentService and its
+// dependencies through the standard grpc.health.v1 service. A Monitor
+// checks each dependency periodically and sets the serving status of the
+// dependency, the service and the server as a whole from the results.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	defaultInterval = 10 * time.Second
+	defaultTimeout  = 2 * time.Second
+)
+
+var (
+	errDown     = errors.New("a required dependency is down")
+	errDegraded = errors.New("degraded: a dependency is down")
+)
+
+// Check returns an error when a dependency cannot be used.
+type Check func(ctx context.Context) error
+
+type dependency struct {
+	name     string
+	check    Check
+	critical bool
+}
+
+// Monitor checks dependencies and publishes their status. Each dependency
+// reports under its own name. The service reports NOT_SERVING while any
+// dependency is down, so clients can see that it is degraded, but the
+// server as a whole ("", which load balancers check) only goes NOT_SERVING
+// while a critical dependency is down.
+type Monitor struct {
+	server   *grpchealth.Server
+	service  string
+	deps     []dependency
+	interval time.Duration
+	timeout  time.Duration
+
+	// last is the previous status of each name, for logging changes.
+	last map[string]healthpb.HealthCheckResponse_ServingStatus
+}
+
+// Option configures optional Monitor behaviour.
+type Option func(*Monitor)
+
+// WithInterval sets how often dependencies are checked.
+func WithInterval(interval time.Duration) Option {
+	return func(m *Monitor) {
+		m.interval = interval
+	}
+}
+
+// WithTimeout sets how long a single check may take before the dependency
+// counts as down.
+func WithTimeout(timeout time.Duration) Option {
+	return func(m *Monitor) {
+		m.timeout = timeout
+	}
+}
+
+// NewMonitor returns a Monitor that publishes to server, reporting the
+// service itself under service. Dependencies are checked every 10 seconds
+// with a timeout of 2 seconds unless options say otherwise.
+func NewMonitor(server *grpchealth.Server, service string, opts ...Option) *Monitor {
+	m := &Monitor{
+		server:   server,
+		service:  service,
+		interval: defaultInterval,
+		timeout:  defaultTimeout,
+		last:     make(map[string]healthpb.HealthCheckResponse_ServingStatus),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Require adds a dependency the service cannot work without.
+func (m *Monitor) Require(name string, check Check) {
+	m.deps = append(m.deps, dependency{name: name, check: check, critical: true})
+}
+
+// Use adds a dependency the service can partly work without.
+func (m *Monitor) Use(name string, check Check) {
+	m.deps = append(m.deps, dependency{name: name, check: check})
+}
+
+// CheckOnce checks every dependency and publishes the results.
+func (m *Monitor) CheckOnce(ctx context.Context) {
+	degraded, down := false, false
+	for _, dep := range m.deps {
+		checkCtx, cancel := context.WithTimeout(ctx, m.timeout)
+		err := dep.check(checkCtx)
+		cancel()
+
+		if err != nil {
+			degraded = true
+			down = down || dep.critical
+		}
+		m.publish(dep.name, err)
+	}
+
+	switch {
+	case down:
+		m.publish(m.service, errDown)
+		m.publish("", errDown)
+	case degraded:
+		m.publish(m.service, errDegraded)
+		m.publish("", nil)
+	default:
+		m.publish(m.service, nil)
+		m.publish("", nil)
+	}
+}
+
+func (m *Monitor) publish(name string, err error) {
+	status := healthpb.HealthCheckResponse_SERVING
+	if err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	m.server.SetServingStatus(name, status)
+
+	if last, ok := m.last[name]; ok && last == status {
+		return
+	}
+	m.last[name] = status
+
+	label := name
+	if label == "" {
+		label = "server"
+	}
+	if err != nil {
+		log.Printf("health: %s is %s: %v", label, status, err)
+	} else {
+		log.Printf("health: %s is %s", label, status)
+	}
+}
+
+// Run checks once immediately and then every interval until ctx is done.
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.CheckOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ConnCheck reports whether conn can reach its server. An idle connection
+// is asked to connect, and the check waits for it to become ready.
+func ConnCheck(conn *grpc.ClientConn) Check {
+	return func(ctx context.Context) error {
+		for {
+			state := conn.GetState()
+			switch state {
+			case connectivity.Ready:
+				return nil
+			case connectivity.Idle:
+				conn.Connect()
+			case connectivity.TransientFailure, connectivity.Shutdown:
+				return fmt.Errorf("connection to %s is %s", conn.Target(), state)
+			}
+
+			if !conn.WaitForStateChange(ctx, state) {
+				return fmt.Errorf("connection to %s is %s: %w", conn.Target(), state, ctx.Err())
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const service = "content.ContentService"
+
+var (
+	serving    = healthpb.HealthCheckResponse_SERVING
+	notServing = healthpb.HealthCheckResponse_NOT_SERVING
+)
+
+// fakeDependency is a dependency whose health the test sets.
+type fakeDependency struct{ err error }
+
+func (d *fakeDependency) check(ctx context.Context) error { return d.err }
+
+func newMonitor(t *testing.T) (*grpchealth.Server, *Monitor, *fakeDependency, *fakeDependency) {
+	t.Helper()
+
+	server := grpchealth.NewServer()
+	monitor := NewMonitor(server, service)
+	db, users := &fakeDependency{}, &fakeDependency{}
+	monitor.Require("db", db.check)
+	monitor.Use("users", users.check)
+	return server, monitor, db, users
+}
+
+// wantStatus checks the published status of each name in want.
+func wantStatus(t *testing.T, server *grpchealth.Server, want map[string]healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+
+	for name, status := range want {
+		resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: name})
+		if err != nil {
+			t.Errorf("Check(%q) = %v", name, err)
+			continue
+		}
+		if resp.Status != status {
+			t.Errorf("status of %q = %s, want %s", name, resp.Status, status)
+		}
+	}
+}
+
+func TestMonitorCriticalDependencyDown(t *testing.T) {
+	server, monitor, db, _ := newMonitor(t)
+	db.err = errors.New("connection refused")
+
+	monitor.CheckOnce(context.Background())
+	wantStatus(t, server, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		"":      notServing,
+		service: notServing,
+		"db":    notServing,
+		"users": serving,
+	})
+}
+
+func TestMonitorDegradedDependencyDown(t *testing.T) {
+	server, monitor, _, users := newMonitor(t)
+	users.err = errors.New("connection refused")
+
+	monitor.CheckOnce(context.Background())
+	wantStatus(t, server, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		"":      serving,
+		service: notServing,
+		"db":    serving,
+		"users": notServing,
+	})
+}
+
+func TestMonitorRecovers(t *testing.T) {
+	server, monitor, db, users := newMonitor(t)
+	db.err, users.err = errors.New("down"), errors.New("down")
+	monitor.CheckOnce(context.Background())
+
+	db.err, users.err = nil, nil
+	monitor.CheckOnce(context.Background())
+	wantStatus(t, server, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		"":      serving,
+		service: serving,
+		"db":    serving,
+		"users": serving,
+	})
+}
+
+func TestMonitorAfterShutdown(t *testing.T) {
+	server, monitor, _, _ := newMonitor(t)
+	monitor.CheckOnce(context.Background())
+
+	// Shutdown marks everything NOT_SERVING for good, so healthy checks
+	// during the drain must not report the server as serving again
+	server.Shutdown()
+	monitor.CheckOnce(context.Background())
+	wantStatus(t, server, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		"":      notServing,
+		service: notServing,
+		"db":    notServing,
+	})
+}
+
+func TestMonitorCheckTimeout(t *testing.T) {
+	server := grpchealth.NewServer()
+	monitor := NewMonitor(server, service, WithTimeout(time.Millisecond))
+	monitor.Require("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	monitor.CheckOnce(context.Background())
+	wantStatus(t, server, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		"":     notServing,
+		"slow": notServing,
+	})
+}
@@ -3,46 +3,243 @@ package models
 import (
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// MongoConfig says how to reach MongoDB. Zero pool sizes and timeouts leave
+// the driver defaults in place, except ConnectTimeout, which defaults to ten
+// seconds and also bounds the startup ping and index creation.
 type MongoConfig struct {
-	URI      string
-	Database string
+	URI                    string
+	Database               string
+	MaxPoolSize            uint64
+	MinPoolSize            uint64
+	MaxConnIdleTime        time.Duration
+	ConnectTimeout         time.Duration
+	ServerSelectionTimeout time.Duration
 }
 
 type Question struct {
-	ID         primitive.ObjectID `bson:"_id" json:"id"`
-	UserID     string             `bson:"user_id" json:"user_id"`
-	Question   string             `bson:"question" json:"question"`
-	Details    string             `bson:"details" json:"details"`
-	Tags       []string           `bson:"tags" json:"tags"`
-	Answers    []Answer           `bson:"answers" json:"answers"`
-	IsAnswered bool               `bson:"is_answered" json:"is_answered"`
-	IsFlagged  bool               `bson:"is_flagged" json:"is_flagged"`
-	Flags      []Flag             `bson:"flags" json:"flags"`
-	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt  time.Time          `bson:"updated_at" json:"updated_at"`
+	ID               primitive.ObjectID  `bson:"_id" json:"id"`
+	UserID           string              `bson:"user_id" json:"user_id"`
+	Question         string              `bson:"question" json:"question"`
+	Details          string              `bson:"details" json:"details"`
+	Tags             []string            `bson:"tags" json:"tags"`
+	AnswerCount      int                 `bson:"answer_count" json:"answer_count"`
+	AcceptedAnswerID *primitive.ObjectID `bson:"accepted_answer_id,omitempty" json:"accepted_answer_id,omitempty"`
+	// IsAnswered is stored so that feeds can filter on it, but is always
+	// AcceptedAnswerID != nil.
+	IsAnswered    bool      `bson:"is_answered" json:"is_answered"`
+	IsFlagged     bool      `bson:"is_flagged" json:"is_flagged"`
+	IsHidden      bool      `bson:"is_hidden,omitempty" json:"is_hidden,omitempty"`
+	AutoHidden    bool      `bson:"auto_hidden,omitempty" json:"auto_hidden,omitempty"`
+	Flags         []Flag    `bson:"flags" json:"flags"`
+	CommentCount  int       `bson:"comment_count" json:"comment_count"`
+	RevisionCount int       `bson:"revision_count" json:"revision_count"`
+	LastEditedBy  string    `bson:"last_edited_by,omitempty" json:"last_edited_by,omitempty"`
+	CreatedAt     time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time `bson:"updated_at" json:"updated_at"`
+	// Deleted questions are kept as tombstones until purged so that they can
+	// be restored.
+	DeletedAt    *time.Time `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy    string     `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+	DeleteReason string     `bson:"delete_reason,omitempty" json:"delete_reason,omitempty"`
 }
 
 type Answer struct {
-	ID         primitive.ObjectID `bson:"_id" json:"id"`
+	ID            primitive.ObjectID `bson:"_id" json:"id"`
+	QuestionID    primitive.ObjectID `bson:"question_id" json:"question_id"`
+	UserID        string             `bson:"user_id" json:"user_id"`
+	Answer        string             `bson:"answer" json:"answer"`
+	Upvotes       int                `bson:"upvotes" json:"upvotes"`
+	Downvotes     int                `bson:"downvotes" json:"downvotes"`
+	IsFlagged     bool               `bson:"is_flagged" json:"is_flagged"`
+	IsHidden      bool               `bson:"is_hidden,omitempty" json:"is_hidden,omitempty"`
+	AutoHidden    bool               `bson:"auto_hidden,omitempty" json:"auto_hidden,omitempty"`
+	Flags         []Flag             `bson:"flags" json:"flags"`
+	Vote          []Vote             `bson:"votes" json:"votes"`
+	CommentCount  int                `bson:"comment_count" json:"comment_count"`
+	RevisionCount int                `bson:"revision_count" json:"revision_count"`
+	LastEditedBy  string             `bson:"last_edited_by,omitempty" json:"last_edited_by,omitempty"`
+	CreatedAt     time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at" json:"updated_at"`
+	// Deleted answers are kept as tombstones until purged so that they can
+	// be restored.
+	DeletedAt    *time.Time `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy    string     `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+	DeleteReason string     `bson:"delete_reason,omitempty" json:"delete_reason,omitempty"`
+	// DeletedWithQuestion marks answers deleted because their question was.
+	// They are restored with it.
+	DeletedWithQuestion bool `bson:"deleted_with_question,omitempty" json:"deleted_with_question,omitempty"`
+}
+
+// Comment is a comment on a question or answer. Replies set ParentID, and
+// Ancestors lists every comment above it in the thread, root first.
+type Comment struct {
+	ID         primitive.ObjectID   `bson:"_id" json:"id"`
+	TargetType string               `bson:"target_type" json:"target_type"`
+	TargetID   primitive.ObjectID   `bson:"target_id" json:"target_id"`
+	QuestionID primitive.ObjectID   `bson:"question_id" json:"question_id"`
+	ParentID   *primitive.ObjectID  `bson:"parent_id,omitempty" json:"parent_id,omitempty"`
+	Ancestors  []primitive.ObjectID `bson:"ancestors" json:"ancestors"`
+	UserID     string               `bson:"user_id" json:"user_id"`
+	Body       string               `bson:"body" json:"body"`
+	IsFlagged  bool                 `bson:"is_flagged" json:"is_flagged"`
+	IsHidden   bool                 `bson:"is_hidden,omitempty" json:"is_hidden,omitempty"`
+	AutoHidden bool                 `bson:"auto_hidden,omitempty" json:"auto_hidden,omitempty"`
+	Flags      []Flag               `bson:"flags" json:"flags"`
+	CreatedAt  time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time            `bson:"updated_at" json:"updated_at"`
+	DeletedAt  *time.Time           `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+}
+
+// DeletedCommentBody replaces the body of a deleted comment, which is kept so
+// that the replies to it still have a thread.
+const DeletedCommentBody = "[deleted]"
+
+// Flag reasons say why a post was flagged. Details holds any free text the
+// reporter added.
+const (
+	FlagReasonSpam       = "spam"
+	FlagReasonOffensive  = "offensive"
+	FlagReasonOffTopic   = "off_topic"
+	FlagReasonDuplicate  = "duplicate"
+	FlagReasonLowQuality = "low_quality"
+	FlagReasonOther      = "other"
+)
+
+// FlagReasons lists every flag reason.
+var FlagReasons = []string{
+	FlagReasonSpam,
+	FlagReasonOffensive,
+	FlagReasonOffTopic,
+	FlagReasonDuplicate,
+	FlagReasonLowQuality,
+	FlagReasonOther,
+}
+
+// Flag resolutions record what a moderator did about a flag.
+const (
+	FlagDismissed = "dismissed"
+	FlagHidden    = "hidden"
+	FlagDeleted   = "deleted"
+	FlagWarned    = "warned"
+)
+
+// Flag is a user's report of a post. It stays open until a moderator
+// resolves it, and each user has at most one open flag on a post. Weight
+// counts towards the post's AutoHide score.
+type Flag struct {
+	UserID         string     `bson:"user_id" json:"user_id"`
+	Reason         string     `bson:"reason" json:"reason"`
+	Details        string     `bson:"details,omitempty" json:"details,omitempty"`
+	Weight         float64    `bson:"weight,omitempty" json:"weight,omitempty"`
+	CreatedAt      time.Time  `bson:"created_at" json:"created_at"`
+	Resolution     string     `bson:"resolution,omitempty" json:"resolution,omitempty"`
+	ResolvedBy     string     `bson:"resolved_by,omitempty" json:"resolved_by,omitempty"`
+	ResolvedAt     *time.Time `bson:"resolved_at,omitempty" json:"resolved_at,omitempty"`
+	ResolutionNote string     `bson:"resolution_note,omitempty" json:"resolution_note,omitempty"`
+}
+
+// IsOpen reports whether no moderator has resolved the flag yet.
+func (f Flag) IsOpen() bool {
+	return f.ResolvedAt == nil
+}
+
+// AutoHide hides a post until a moderator reviews it once Flags distinct
+// users have open flags on it, or the weights of its open flags add up to
+// Score. Zero disables either limit.
+type AutoHide struct {
+	Flags int
+	Score float64
+}
+
+// DefaultAutoHide hides a post once five users flag it. Since flags from
+// moderators weigh more, two of them are enough.
+var DefaultAutoHide = AutoHide{Flags: 5, Score: 5}
+
+// Limits bounds the size of questions and answers.
+type Limits struct {
+	MinQuestionLength int
+	MaxDetailsLength  int
+	MinAnswerLength   int
+	MaxAnswerLength   int
+	MaxTags           int
+}
+
+// DefaultLimits are the limits used unless others are configured.
+var DefaultLimits = Limits{
+	MinQuestionLength: 10,
+	MaxDetailsLength:  30000,
+	MinAnswerLength:   20,
+	MaxAnswerLength:   30000,
+	MaxTags:           5,
+}
+
+// Tag policies say how PostQuestion treats tags that are not in the catalog.
+const (
+	// TagPolicyAllow accepts any tag that passes validation.
+	TagPolicyAllow = "allow"
+	// TagPolicyReject rejects questions that use tags missing from the catalog.
+	TagPolicyReject = "reject"
+	// TagPolicyCreate adds missing tags to the catalog.
+	TagPolicyCreate = "create"
+)
+
+// How long deleted content is kept, and how often it is purged, unless
+// configured otherwise.
+const (
+	DefaultPurgeRetention = 30 * 24 * time.Hour
+	DefaultPurgeInterval  = time.Hour
+)
+
+// FlagCountQuery selects the flags counted by reason. An empty TargetType
+// counts flags on every kind of post and a zero Since counts them all.
+type FlagCountQuery struct {
+	TargetType      string
+	Since           time.Time
+	IncludeResolved bool
+}
+
+// FlagResolution is a moderator's decision on the open flags of a post.
+type FlagResolution struct {
+	Resolution  string `bson:"resolution" json:"resolution"`
+	ModeratorID string `bson:"moderator_id" json:"moderator_id"`
+	Note        string `bson:"note,omitempty" json:"note,omitempty"`
+}
+
+// ModerationItem is a flagged question, answer or comment in the
+// moderation queue, with its open flags. FlaggedAt is the time of the most
+// recent one.
+type ModerationItem struct {
+	TargetType string             `bson:"target_type" json:"target_type"`
+	TargetID   primitive.ObjectID `bson:"_id" json:"target_id"`
 	QuestionID primitive.ObjectID `bson:"question_id" json:"question_id"`
 	UserID     string             `bson:"user_id" json:"user_id"`
-	Answer     string             `bson:"answer" json:"answer"`
-	Upvotes    int                `bson:"upvotes" json:"upvotes"`
-	Downvotes  int                `bson:"downvotes" json:"downvotes"`
-	IsFlagged  bool               `bson:"is_flagged" json:"is_flagged"`
+	Body       string             `bson:"body" json:"body"`
+	IsHidden   bool               `bson:"is_hidden" json:"is_hidden"`
+	AutoHidden bool               `bson:"auto_hidden" json:"auto_hidden"`
 	Flags      []Flag             `bson:"flags" json:"flags"`
-	Vote       []Vote             `bson:"votes" json:"votes"`
-	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt  time.Time          `bson:"updated_at" json:"updated_at"`
+	FlaggedAt  time.Time          `bson:"flagged_at" json:"flagged_at"`
 }
 
-type Flag struct {
-	UserID    string    `bson:"user_id" json:"user_id"`
-	Reason    string    `bson:"reason" json:"reason"`
-	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+// ModerationPage is a page of the moderation queue. Its cursor is in
+// (flagged_at, _id) order.
+type ModerationPage struct {
+	Items []ModerationItem
+	Next  *Cursor
+}
+
+// Warning is a moderator's warning to a user about one of their posts.
+type Warning struct {
+	ID          primitive.ObjectID `bson:"_id" json:"id"`
+	UserID      string             `bson:"user_id" json:"user_id"`
+	ModeratorID string             `bson:"moderator_id" json:"moderator_id"`
+	TargetType  string             `bson:"target_type" json:"target_type"`
+	TargetID    primitive.ObjectID `bson:"target_id" json:"target_id"`
+	Reason      string             `bson:"reason" json:"reason"`
+	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
 }
 
 type Tag struct {
@@ -53,11 +250,183 @@ type Tag struct {
 }
 
 type SearchResult struct {
-	Questions []Question `json:"questions"`
+	Hits  []SearchHit `json:"hits"`
+	Total int64       `json:"total"`
+	// Next is the offset the following page starts at, or 0 when this is
+	// the last page.
+	Next int `json:"next,omitempty"`
+}
+
+// SearchHit is a question matching a search, with the best matching answer
+// when the keyword was found in one.
+type SearchHit struct {
+	Question Question `json:"question"`
+	Answer   *Answer  `json:"answer,omitempty"`
+	Score    float64  `json:"score"`
+}
+
+// Target types identify what a revision, comment or flag belongs to.
+const (
+	TargetQuestion = "question"
+	TargetAnswer   = "answer"
+	TargetComment  = "comment"
+)
+
+// Revision is a stored version of a question or answer. Revision 1 is the
+// original post and every edit adds the next one.
+type Revision struct {
+	ID          primitive.ObjectID `bson:"_id" json:"id"`
+	TargetType  string             `bson:"target_type" json:"target_type"`
+	TargetID    primitive.ObjectID `bson:"target_id" json:"target_id"`
+	QuestionID  primitive.ObjectID `bson:"question_id" json:"question_id"`
+	Revision    int                `bson:"revision" json:"revision"`
+	EditorID    string             `bson:"editor_id" json:"editor_id"`
+	EditSummary string             `bson:"edit_summary" json:"edit_summary"`
+	Question    string             `bson:"question,omitempty" json:"question,omitempty"`
+	Details     string             `bson:"details,omitempty" json:"details,omitempty"`
+	Tags        []string           `bson:"tags,omitempty" json:"tags,omitempty"`
+	Answer      string             `bson:"answer,omitempty" json:"answer,omitempty"`
+	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
 }
 
+const (
+	VoteTypeUpvote   = "upvote"
+	VoteTypeDownvote = "downvote"
+)
+
 type Vote struct {
 	UserID   string    `bson:"user_id"`
 	VoteType string    `bson:"vote_type"`
 	VotedAt  time.Time `bson:"voted_at"`
 }
+
+// Cursor identifies the last item of a page in (created_at, _id) order.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        primitive.ObjectID
+}
+
+// Page selects up to Limit items, newest first, that come after the cursor.
+type Page struct {
+	Limit int
+	After *Cursor
+}
+
+type QuestionPage struct {
+	Questions []Question
+	Next      *Cursor
+	Total     int64
+}
+
+type AnswerPage struct {
+	Answers []Answer
+	Next    *Cursor
+	Total   int64
+}
+
+type CommentPage struct {
+	Comments []Comment
+	Next     *Cursor
+}
+
+type TagPage struct {
+	Tags []Tag
+	Next *Cursor
+}
+
+// Follow types identify what a user follows.
+const (
+	FollowTag  = "tag"
+	FollowUser = "user"
+)
+
+// Follow records that UserID follows a tag or another user, given by Target.
+type Follow struct {
+	ID         primitive.ObjectID `bson:"_id" json:"id"`
+	UserID     string             `bson:"user_id" json:"user_id"`
+	TargetType string             `bson:"target_type" json:"target_type"`
+	Target     string             `bson:"target" json:"target"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+}
+
+type FollowPage struct {
+	Follows []Follow
+	Next    *Cursor
+}
+
+// FeedQuery selects a page of a user's feed. Scores are computed as of AsOf
+// and questions posted after it are left out, so that paging through a feed
+// sees a stable ranking.
+type FeedQuery struct {
+	UserID         string
+	Tags           []string
+	UnansweredOnly bool
+	AsOf           time.Time
+	Limit          int
+	Offset         int
+}
+
+type FeedPage struct {
+	Questions []Question
+	HasMore   bool
+}
+
+// Audit actions name the privileged changes recorded in the audit log.
+const (
+	AuditQuestionDelete  = "question.delete"
+	AuditQuestionRestore = "question.restore"
+	AuditAnswerDelete    = "answer.delete"
+	AuditAnswerRestore   = "answer.restore"
+	AuditAnswerAccept    = "answer.accept"
+	AuditAnswerUnaccept  = "answer.unaccept"
+	AuditCommentDelete   = "comment.delete"
+	AuditFlagResolve     = "flag.resolve"
+	AuditFlagReopen      = "flag.reopen"
+	AuditTagAdd          = "tag.add"
+	AuditTagUpdate       = "tag.update"
+	AuditTagRemove       = "tag.remove"
+)
+
+// TargetTag identifies a tag in the audit log, where TargetID is its name.
+const TargetTag = "tag"
+
+// AuditEvent records who changed what. Before and After are snapshots of
+// the target around the change; either is empty when the target did not
+// exist at that point or was not worth storing again.
+type AuditEvent struct {
+	ID         primitive.ObjectID `bson:"_id" json:"id"`
+	ActorID    string             `bson:"actor_id" json:"actor_id"`
+	ActorRole  string             `bson:"actor_role" json:"actor_role"`
+	Action     string             `bson:"action" json:"action"`
+	TargetType string             `bson:"target_type" json:"target_type"`
+	TargetID   string             `bson:"target_id" json:"target_id"`
+	Reason     string             `bson:"reason,omitempty" json:"reason,omitempty"`
+	Before     bson.Raw           `bson:"before,omitempty" json:"before,omitempty"`
+	After      bson.Raw           `bson:"after,omitempty" json:"after,omitempty"`
+	Request    RequestMetadata    `bson:"request" json:"request"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// RequestMetadata describes the RPC that caused an audit event.
+type RequestMetadata struct {
+	Method    string `bson:"method,omitempty" json:"method,omitempty"`
+	PeerAddr  string `bson:"peer_addr,omitempty" json:"peer_addr,omitempty"`
+	UserAgent string `bson:"user_agent,omitempty" json:"user_agent,omitempty"`
+	RequestID string `bson:"request_id,omitempty" json:"request_id,omitempty"`
+}
+
+// AuditQuery filters the audit log. Empty fields match every event, and
+// Since and Until bound created_at when they are set.
+type AuditQuery struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	Since      time.Time
+	Until      time.Time
+}
+
+type AuditPage struct {
+	Events []AuditEvent
+	Next   *Cursor
+}
@@ -0,0 +1,121 @@
+// Package policy enforces which roles may call each RPC. Rules are declared
+// per full gRPC method name and checked by a server interceptor, which also
+// records the caller's role in the context for handlers that relax their own
+// checks for moderators.
+package policy
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/liju-github/ContentService/internal/auth"
+	"github.com/liju-github/ContentService/internal/errs"
+)
+
+// Role is the level of access a caller has. Each role includes the ones
+// below it.
+type Role int
+
+const (
+	RoleAnonymous Role = iota
+	RoleUser
+	RoleModerator
+	RoleAdmin
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleUser:
+		return "user"
+	case RoleModerator:
+		return "moderator"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return "anonymous"
+	}
+}
+
+// Roles looks up the role of an authenticated user.
+type Roles interface {
+	RoleOf(ctx context.Context, userID string) (Role, error)
+}
+
+// StaticRoles assigns roles to fixed user IDs. Everyone else is a user.
+type StaticRoles map[string]Role
+
+// Assign gives role to each of userIDs. Blank IDs are ignored.
+func (s StaticRoles) Assign(role Role, userIDs ...string) {
+	for _, id := range userIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			s[id] = role
+		}
+	}
+}
+
+func (s StaticRoles) RoleOf(ctx context.Context, userID string) (Role, error) {
+	if role, ok := s[userID]; ok {
+		return role, nil
+	}
+	return RoleUser, nil
+}
+
+type contextKey struct{}
+
+// RoleFromContext returns the caller's role as recorded by the interceptor.
+func RoleFromContext(ctx context.Context) Role {
+	role, _ := ctx.Value(contextKey{}).(Role)
+	return role
+}
+
+// HasRole reports whether the caller has at least the given role.
+func HasRole(ctx context.Context, role Role) bool {
+	return RoleFromContext(ctx) >= role
+}
+
+// Policy maps full method names to the minimum role needed to call them.
+// Methods without a rule are open to everyone.
+type Policy struct {
+	roles Roles
+	rules map[string]Role
+}
+
+func New(roles Roles, rules map[string]Role) *Policy {
+	return &Policy{roles: roles, rules: rules}
+}
+
+// Authorize resolves the caller's role, adds it to ctx and checks it against
+// the rule for method.
+func (p *Policy) Authorize(ctx context.Context, method string) (context.Context, error) {
+	role := RoleAnonymous
+	if id, ok := auth.FromContext(ctx); ok {
+		var err error
+		if role, err = p.roles.RoleOf(ctx, id.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	needed := p.rules[method]
+	switch {
+	case role >= needed:
+		return context.WithValue(ctx, contextKey{}, role), nil
+	case role == RoleAnonymous:
+		return nil, errs.Unauthenticatedf("authentication required")
+	default:
+		return nil, errs.PermissionDeniedf("%s role required", needed)
+	}
+}
+
+// UnaryServerInterceptor enforces the policy on unary calls. It must run
+// after the Authenticator's interceptor.
+func (p *Policy) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := p.Authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,75 @@
+// Package purge runs the background job that hard-deletes soft-deleted
+// content once its retention window has passed.
+package purge
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+const (
+	DefaultRetention = models.DefaultPurgeRetention
+	DefaultInterval  = models.DefaultPurgeInterval
+)
+
+// Purger removes content that was deleted before a point in time.
+type Purger interface {
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Worker periodically purges content deleted more than Retention ago.
+type Worker struct {
+	purger    Purger
+	retention time.Duration
+	interval  time.Duration
+	now       func() time.Time
+}
+
+// NewWorker returns a Worker that runs every interval and purges content
+// deleted more than retention ago. Zero values use the defaults of 30 days
+// and one hour.
+func NewWorker(purger Purger, retention, interval time.Duration) *Worker {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	return &Worker{
+		purger:    purger,
+		retention: retention,
+		interval:  interval,
+		now:       time.Now,
+	}
+}
+
+// RunOnce purges everything past the retention window.
+func (w *Worker) RunOnce(ctx context.Context) (int64, error) {
+	return w.purger.PurgeDeleted(ctx, w.now().Add(-w.retention))
+}
+
+// Run purges once immediately and then every interval until ctx is done.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := w.RunOnce(ctx)
+		switch {
+		case err != nil:
+			log.Printf("purge: %v", err)
+		case purged > 0:
+			log.Printf("purge: removed %d deleted questions and answers", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,439 @@
+package mongodb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// answerFilter matches a single answer of a question in the answers
+// collection. Deleted answers are left out.
+func answerFilter(questionID, answerID string) (bson.M, error) {
+	filter, err := anyAnswerFilter(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter["deleted_at"] = nil
+	return filter, nil
+}
+
+// anyAnswerFilter is answerFilter including deleted answers.
+func anyAnswerFilter(questionID, answerID string) (bson.M, error) {
+	qID, err := ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	aID, err := ParseID("answer_id", answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return bson.M{"_id": aID, "question_id": qID}, nil
+}
+
+func (r *MongoRepository) GetAnswersByQuestionID(ctx context.Context, questionID string) ([]models.Answer, error) {
+	qID, err := ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := r.answers.Find(ctx, bson.M{"question_id": qID, "deleted_at": nil, "is_hidden": bson.M{"$ne": true}}, opts)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	answers := []models.Answer{}
+	if err = cursor.All(ctx, &answers); err != nil {
+		return nil, dbError(err)
+	}
+
+	return answers, nil
+}
+
+func (r *MongoRepository) GetAnswerByID(ctx context.Context, questionID, answerID string) (*models.Answer, error) {
+	filter, err := answerFilter(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var answer models.Answer
+	err = r.answers.FindOne(ctx, filter).Decode(&answer)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.NotFoundf("answer not found")
+		}
+		return nil, dbError(err)
+	}
+
+	return &answer, nil
+}
+
+func (r *MongoRepository) GetAnswerOwnerID(ctx context.Context, questionID, answerID string) (string, error) {
+	filter, err := answerFilter(questionID, answerID)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		UserID string `bson:"user_id"`
+	}
+
+	err = r.answers.FindOne(ctx, filter, options.FindOne().SetProjection(bson.M{"user_id": 1})).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return "", errs.NotFoundf("answer not found")
+		}
+		return "", dbError(err)
+	}
+
+	return result.UserID, nil
+}
+
+// PostAnswer increments the counter first so that an answer is never stored
+// for a question that does not exist.
+func (r *MongoRepository) PostAnswer(ctx context.Context, questionID string, answer *models.Answer) error {
+	qID, err := ParseID("question_id", questionID)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.questions.UpdateOne(ctx, bson.M{"_id": qID, "deleted_at": nil}, bson.M{
+		"$inc": bson.M{"answer_count": 1},
+	})
+	if err != nil {
+		return dbError(err)
+	}
+
+	if result.MatchedCount == 0 {
+		return errs.NotFoundf("question not found")
+	}
+
+	answer.ID = primitive.NewObjectID()
+	answer.QuestionID = qID
+	answer.CreatedAt = time.Now()
+	if answer.Vote == nil {
+		answer.Vote = []models.Vote{}
+	}
+	if answer.Flags == nil {
+		answer.Flags = []models.Flag{}
+	}
+
+	if _, err := r.answers.InsertOne(ctx, answer); err != nil {
+		// Undo the increment even if the insert failed because ctx was
+		// cancelled; if that fails too, the count stays one too high
+		_, undoErr := r.questions.UpdateOne(context.WithoutCancel(ctx), bson.M{"_id": qID}, bson.M{"$inc": bson.M{"answer_count": -1}})
+		if undoErr != nil {
+			log.Printf("question %s: answer_count left one too high after a failed answer insert: %v", qID.Hex(), undoErr)
+		}
+		return dbError(err)
+	}
+
+	return nil
+}
+
+func (r *MongoRepository) GetDeletedAnswer(ctx context.Context, questionID, answerID string) (*models.Answer, error) {
+	filter, err := anyAnswerFilter(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+	filter["deleted_at"] = bson.M{"$ne": nil}
+
+	var answer models.Answer
+	err = r.answers.FindOne(ctx, filter).Decode(&answer)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.NotFoundf("deleted answer not found")
+		}
+		return nil, dbError(err)
+	}
+
+	return &answer, nil
+}
+
+func (r *MongoRepository) DeleteAnswer(ctx context.Context, questionID, answerID, deletedBy, reason string) error {
+	filter, err := answerFilter(questionID, answerID)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.answers.UpdateOne(ctx, filter, bson.M{"$set": bson.M{
+		"deleted_at":    time.Now(),
+		"deleted_by":    deletedBy,
+		"delete_reason": reason,
+	}})
+	if err != nil {
+		return dbError(err)
+	}
+
+	if result.MatchedCount == 0 {
+		return errs.NotFoundf("answer not found")
+	}
+
+	_, err = r.questions.UpdateOne(ctx, bson.M{"_id": filter["question_id"]}, bson.M{
+		"$inc": bson.M{"answer_count": -1},
+	})
+	if err != nil {
+		return dbError(err)
+	}
+
+	// A deleted answer can no longer be the accepted one
+	_, err = r.questions.UpdateOne(ctx, bson.M{
+		"_id":                filter["question_id"],
+		"accepted_answer_id": filter["_id"],
+	}, bson.M{
+		"$unset": bson.M{"accepted_answer_id": ""},
+		"$set":   bson.M{"is_answered": false},
+	})
+	return dbError(err)
+}
+
+func (r *MongoRepository) RestoreAnswer(ctx context.Context, questionID, answerID string) (*models.Answer, error) {
+	filter, err := anyAnswerFilter(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+	filter["deleted_at"] = bson.M{"$ne": nil}
+	filter["deleted_with_question"] = bson.M{"$ne": true}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var answer models.Answer
+	err = r.answers.FindOneAndUpdate(ctx, filter, bson.M{
+		"$unset": bson.M{"deleted_at": "", "deleted_by": "", "delete_reason": ""},
+	}, opts).Decode(&answer)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.NotFoundf("deleted answer not found")
+		}
+		return nil, dbError(err)
+	}
+
+	_, err = r.questions.UpdateOne(ctx, bson.M{"_id": answer.QuestionID}, bson.M{
+		"$inc": bson.M{"answer_count": 1},
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return &answer, nil
+}
+
+func (r *MongoRepository) FlagAnswer(ctx context.Context, questionID, answerID string, flag models.Flag, hide models.AutoHide) error {
+	filter, err := answerFilter(questionID, answerID)
+	if err != nil {
+		return err
+	}
+
+	return addFlag(ctx, r.answers, models.TargetAnswer, filter, flag, hide)
+}
+
+func (r *MongoRepository) GetFlaggedAnswers(ctx context.Context, page models.Page) (*models.AnswerPage, error) {
+	match := bson.M{"is_flagged": true, "deleted_at": nil}
+
+	totalCount, err := r.answers.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	cursor, err := r.answers.Find(ctx, pageFilter(match, page), pageOptions(page))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var answers []models.Answer
+	if err = cursor.All(ctx, &answers); err != nil {
+		return nil, dbError(err)
+	}
+
+	result := answerPage(answers, page)
+	result.Total = totalCount
+	return result, nil
+}
+
+func (r *MongoRepository) HasUserVotedOnAnswer(ctx context.Context, questionID, answerID, userID string) (bool, string, error) {
+	answer, err := r.GetAnswerByID(ctx, questionID, answerID)
+	if err != nil {
+		return false, "", err
+	}
+
+	// Check if user has voted
+	for _, vote := range answer.Vote {
+		if vote.UserID == userID {
+			return true, vote.VoteType, nil
+		}
+	}
+
+	return false, "", nil
+}
+
+func (r *MongoRepository) UpvoteAnswer(ctx context.Context, questionID, answerID, userID string) error {
+	return r.castVote(ctx, questionID, answerID, userID, models.VoteTypeUpvote)
+}
+
+func (r *MongoRepository) DownvoteAnswer(ctx context.Context, questionID, answerID, userID string) error {
+	return r.castVote(ctx, questionID, answerID, userID, models.VoteTypeDownvote)
+}
+
+// castVote records the user's vote in the answer's votes ledger. Every step is
+// a single conditional update that also adjusts the counters, so concurrent
+// requests from the same user can never be counted twice.
+func (r *MongoRepository) castVote(ctx context.Context, questionID, answerID, userID, voteType string) error {
+	filter, err := answerFilter(questionID, answerID)
+	if err != nil {
+		return err
+	}
+
+	opposite := models.VoteTypeDownvote
+	if voteType == models.VoteTypeDownvote {
+		opposite = models.VoteTypeUpvote
+	}
+
+	// Record a new vote if the user has not voted on this answer yet
+	result, err := r.answers.UpdateOne(ctx, withFilter(filter, bson.M{
+		"votes.user_id": bson.M{"$ne": userID},
+	}), bson.M{
+		"$inc": bson.M{voteCounterField(voteType): 1},
+		"$push": bson.M{"votes": models.Vote{
+			UserID:   userID,
+			VoteType: voteType,
+			VotedAt:  time.Now(),
+		}},
+	})
+	if err != nil {
+		return dbError(err)
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	// Otherwise switch an existing vote of the opposite type
+	result, err = r.answers.UpdateOne(ctx, withFilter(filter, bson.M{
+		"votes": bson.M{"$elemMatch": bson.M{"user_id": userID, "vote_type": opposite}},
+	}), bson.M{
+		"$inc": bson.M{
+			voteCounterField(voteType): 1,
+			voteCounterField(opposite): -1,
+		},
+		"$set": bson.M{
+			"votes.$.vote_type": voteType,
+			"votes.$.voted_at":  time.Now(),
+		},
+	})
+	if err != nil {
+		return dbError(err)
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	// Neither update applied: the answer is missing or the vote already exists
+	if _, err := r.GetAnswerByID(ctx, questionID, answerID); err != nil {
+		return err
+	}
+
+	return errs.AlreadyExistsf("already %sd", voteType)
+}
+
+// maxVoteAttempts bounds how often ToggleVote retries when a concurrent
+// request changes the user's vote between its conditional updates.
+const maxVoteAttempts = 3
+
+// ToggleVote tries, in turn, to retract a vote of voteType, to switch a vote
+// of the opposite type and to record a first vote. Each step is a single
+// conditional update that also adjusts the counters, so the step that matched
+// is the outcome.
+func (r *MongoRepository) ToggleVote(ctx context.Context, questionID, answerID, userID, voteType string) (*models.Answer, error) {
+	filter, err := answerFilter(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	opposite := models.VoteTypeDownvote
+	if voteType == models.VoteTypeDownvote {
+		opposite = models.VoteTypeUpvote
+	}
+
+	now := time.Now()
+	steps := []struct{ match, update bson.M }{
+		{
+			match: bson.M{"votes": bson.M{"$elemMatch": bson.M{"user_id": userID, "vote_type": voteType}}},
+			update: bson.M{
+				"$inc":  bson.M{voteCounterField(voteType): -1},
+				"$pull": bson.M{"votes": bson.M{"user_id": userID}},
+			},
+		},
+		{
+			match: bson.M{"votes": bson.M{"$elemMatch": bson.M{"user_id": userID, "vote_type": opposite}}},
+			update: bson.M{
+				"$inc": bson.M{
+					voteCounterField(voteType): 1,
+					voteCounterField(opposite): -1,
+				},
+				"$set": bson.M{
+					"votes.$.vote_type": voteType,
+					"votes.$.voted_at":  now,
+				},
+			},
+		},
+		{
+			match: bson.M{"votes.user_id": bson.M{"$ne": userID}},
+			update: bson.M{
+				"$inc":  bson.M{voteCounterField(voteType): 1},
+				"$push": bson.M{"votes": models.Vote{UserID: userID, VoteType: voteType, VotedAt: now}},
+			},
+		},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	for attempt := 0; attempt < maxVoteAttempts; attempt++ {
+		for _, step := range steps {
+			var answer models.Answer
+			err := r.answers.FindOneAndUpdate(ctx, withFilter(filter, step.match), step.update, opts).Decode(&answer)
+			if err == nil {
+				return &answer, nil
+			}
+			if err != mongo.ErrNoDocuments {
+				return nil, dbError(err)
+			}
+		}
+
+		// No step matched: the answer is missing, or the vote changed between steps
+		if _, err := r.GetAnswerByID(ctx, questionID, answerID); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, errs.New(errs.Unavailable, "vote changed concurrently; try again")
+}
+
+func voteCounterField(voteType string) string {
+	if voteType == models.VoteTypeDownvote {
+		return "downvotes"
+	}
+	return "upvotes"
+}
+
+// withFilter returns a copy of base with the extra conditions added.
+func withFilter(base, extra bson.M) bson.M {
+	filter := make(bson.M, len(base)+len(extra))
+	for k, v := range base {
+		filter[k] = v
+	}
+	for k, v := range extra {
+		filter[k] = v
+	}
+	return filter
+}
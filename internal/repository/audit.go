@@ -0,0 +1,57 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+func (r *MongoRepository) RecordAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	event.ID = primitive.NewObjectID()
+	event.CreatedAt = time.Now()
+
+	_, err := r.audit.InsertOne(ctx, event)
+	return dbError(err)
+}
+
+func (r *MongoRepository) ListAuditEvents(ctx context.Context, query models.AuditQuery, page models.Page) (*models.AuditPage, error) {
+	filter := bson.M{}
+	for field, value := range map[string]string{
+		"actor_id":    query.ActorID,
+		"action":      query.Action,
+		"target_type": query.TargetType,
+		"target_id":   query.TargetID,
+	} {
+		if value != "" {
+			filter[field] = value
+		}
+	}
+
+	createdAt := bson.M{}
+	if !query.Since.IsZero() {
+		createdAt["$gte"] = query.Since
+	}
+	if !query.Until.IsZero() {
+		createdAt["$lt"] = query.Until
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+
+	cursor, err := r.audit.Find(ctx, pageFilter(filter, page), pageOptions(page))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var events []models.AuditEvent
+	if err = cursor.All(ctx, &events); err != nil {
+		return nil, dbError(err)
+	}
+
+	return auditPage(events, page), nil
+}
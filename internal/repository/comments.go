@@ -0,0 +1,176 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// commentTarget returns the collection and filter of the question or answer
+// a comment belongs to.
+func (r *MongoRepository) commentTarget(comment *models.Comment) (*mongo.Collection, bson.M) {
+	if comment.TargetType == models.TargetAnswer {
+		return r.answers, bson.M{"_id": comment.TargetID, "question_id": comment.QuestionID, "deleted_at": nil}
+	}
+	return r.questions, bson.M{"_id": comment.QuestionID, "deleted_at": nil}
+}
+
+func (r *MongoRepository) adjustCommentCount(ctx context.Context, comment *models.Comment, delta int64) (bool, error) {
+	collection, filter := r.commentTarget(comment)
+	result, err := collection.UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"comment_count": delta}})
+	if err != nil {
+		return false, dbError(err)
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func (r *MongoRepository) PostComment(ctx context.Context, comment *models.Comment) error {
+	comment.Ancestors = []primitive.ObjectID{}
+	if comment.ParentID != nil {
+		parent, err := r.GetCommentByID(ctx, comment.ParentID.Hex())
+		if err != nil {
+			return err
+		}
+
+		if parent.TargetType != comment.TargetType || parent.TargetID != comment.TargetID {
+			return errs.InvalidField("parent_id", "parent comment belongs to a different post")
+		}
+
+		comment.Ancestors = append(parent.Ancestors, parent.ID)
+	}
+
+	found, err := r.adjustCommentCount(ctx, comment, 1)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errs.NotFoundf("%s not found", comment.TargetType)
+	}
+
+	comment.ID = primitive.NewObjectID()
+	comment.CreatedAt = time.Now()
+	comment.UpdatedAt = comment.CreatedAt
+	if comment.Flags == nil {
+		comment.Flags = []models.Flag{}
+	}
+
+	if _, err := r.comments.InsertOne(ctx, comment); err != nil {
+		r.adjustCommentCount(ctx, comment, -1)
+		return dbError(err)
+	}
+
+	return nil
+}
+
+func (r *MongoRepository) GetCommentByID(ctx context.Context, commentID string) (*models.Comment, error) {
+	id, err := ParseID("comment_id", commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment models.Comment
+	err = r.comments.FindOne(ctx, bson.M{"_id": id, "deleted_at": nil}).Decode(&comment)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.NotFoundf("comment not found")
+		}
+		return nil, dbError(err)
+	}
+
+	return &comment, nil
+}
+
+func (r *MongoRepository) EditComment(ctx context.Context, commentID, body string) (*models.Comment, error) {
+	comment, err := r.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result, err := r.comments.UpdateOne(ctx, bson.M{"_id": comment.ID, "deleted_at": nil}, bson.M{
+		"$set": bson.M{"body": body, "updated_at": now},
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	if result.MatchedCount == 0 {
+		return nil, errs.NotFoundf("comment not found")
+	}
+
+	comment.Body = body
+	comment.UpdatedAt = now
+	return comment, nil
+}
+
+func (r *MongoRepository) DeleteComment(ctx context.Context, commentID string) error {
+	comment, err := r.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.comments.UpdateOne(ctx, bson.M{"_id": comment.ID, "deleted_at": nil}, bson.M{
+		"$set": bson.M{"body": models.DeletedCommentBody, "deleted_at": time.Now()},
+	})
+	if err != nil {
+		return dbError(err)
+	}
+
+	if result.MatchedCount == 0 {
+		return errs.NotFoundf("comment not found")
+	}
+
+	_, err = r.adjustCommentCount(ctx, comment, -1)
+	return err
+}
+
+func (r *MongoRepository) ListComments(ctx context.Context, targetType, targetID string, page models.Page) (*models.CommentPage, error) {
+	id, err := ParseID("target_id", targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if targetType != models.TargetQuestion && targetType != models.TargetAnswer {
+		return nil, errs.InvalidField("target_type", "must be question or answer")
+	}
+
+	target, _ := r.moderationCollection(targetType)
+	err = target.FindOne(ctx, bson.M{"_id": id, "deleted_at": nil}, options.FindOne().SetProjection(bson.M{"_id": 1})).Err()
+	if err == mongo.ErrNoDocuments {
+		return nil, errs.NotFoundf("%s not found", targetType)
+	}
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	filter := bson.M{"target_type": targetType, "target_id": id, "is_hidden": bson.M{"$ne": true}}
+	cursor, err := r.comments.Find(ctx, pageFilter(filter, page), pageOptions(page))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var comments []models.Comment
+	if err = cursor.All(ctx, &comments); err != nil {
+		return nil, dbError(err)
+	}
+
+	return commentPage(comments, page), nil
+}
+
+func (r *MongoRepository) FlagComment(ctx context.Context, commentID string, flag models.Flag, hide models.AutoHide) error {
+	id, err := ParseID("comment_id", commentID)
+	if err != nil {
+		return err
+	}
+
+	return addFlag(ctx, r.comments, models.TargetComment, bson.M{"_id": id, "deleted_at": nil}, flag, hide)
+}
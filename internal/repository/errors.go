@@ -0,0 +1,37 @@
+package mongodb
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/liju-github/ContentService/internal/errs"
+)
+
+// ParseID parses the hex ID given in the request field named field. Every
+// backend uses it, so all of them reject a malformed ID with the same
+// InvalidArgument error.
+func ParseID(field, hex string) (primitive.ObjectID, error) {
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return primitive.NilObjectID, errs.InvalidField(field, "must be a valid ID")
+	}
+	return id, nil
+}
+
+// dbError gives MongoDB driver errors a kind.
+func dbError(err error) error {
+	return errs.FromDriver(err, func(err error) (errs.Kind, string, bool) {
+		switch {
+		case errors.Is(err, mongo.ErrNoDocuments):
+			return errs.NotFound, "not found", true
+		case mongo.IsDuplicateKeyError(err):
+			return errs.AlreadyExists, "already exists", true
+		case mongo.IsNetworkError(err), mongo.IsTimeout(err), errors.Is(err, mongo.ErrClientDisconnected):
+			return errs.Unavailable, "database unavailable", true
+		default:
+			return errs.Internal, "", false
+		}
+	})
+}
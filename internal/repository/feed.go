@@ -0,0 +1,174 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+const (
+	// feedWindow is how far back the feed looks for questions that match
+	// none of the user's follows.
+	feedWindow = 30 * 24 * time.Hour
+
+	// Feed score weights. Each followed tag on a question and a followed
+	// author add a fixed amount, unanswered questions get a small boost and
+	// recency decays from feedRecencyWeight for a new question to half of it
+	// after a day.
+	feedTagWeight        = 3.0
+	feedAuthorWeight     = 5.0
+	feedUnansweredWeight = 1.0
+	feedRecencyWeight    = 4.0
+)
+
+func (r *MongoRepository) Follow(ctx context.Context, follow *models.Follow) error {
+	follow.ID = primitive.NewObjectID()
+	follow.CreatedAt = time.Now()
+
+	_, err := r.follows.InsertOne(ctx, follow)
+	if mongo.IsDuplicateKeyError(err) {
+		return errs.AlreadyExistsf("already following %s", follow.TargetType)
+	}
+	return dbError(err)
+}
+
+func (r *MongoRepository) Unfollow(ctx context.Context, userID, targetType, target string) error {
+	result, err := r.follows.DeleteOne(ctx, bson.M{
+		"user_id":     userID,
+		"target_type": targetType,
+		"target":      target,
+	})
+	if err != nil {
+		return dbError(err)
+	}
+
+	if result.DeletedCount == 0 {
+		return errs.NotFoundf("not following %s", targetType)
+	}
+
+	return nil
+}
+
+func (r *MongoRepository) ListFollows(ctx context.Context, userID, targetType string, page models.Page) (*models.FollowPage, error) {
+	filter := bson.M{"user_id": userID}
+	if targetType != "" {
+		filter["target_type"] = targetType
+	}
+
+	cursor, err := r.follows.Find(ctx, pageFilter(filter, page), pageOptions(page))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var follows []models.Follow
+	if err = cursor.All(ctx, &follows); err != nil {
+		return nil, dbError(err)
+	}
+
+	return followPage(follows, page), nil
+}
+
+// followedTargets returns the tags and users a user follows.
+func (r *MongoRepository) followedTargets(ctx context.Context, userID string) (tags, users []string, err error) {
+	cursor, err := r.follows.Find(ctx, bson.M{"user_id": userID},
+		options.Find().SetProjection(bson.M{"target_type": 1, "target": 1}))
+	if err != nil {
+		return nil, nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var follows []models.Follow
+	if err = cursor.All(ctx, &follows); err != nil {
+		return nil, nil, dbError(err)
+	}
+
+	tags, users = []string{}, []string{}
+	for _, follow := range follows {
+		switch follow.TargetType {
+		case models.FollowTag:
+			tags = append(tags, follow.Target)
+		case models.FollowUser:
+			users = append(users, follow.Target)
+		}
+	}
+
+	return tags, users, nil
+}
+
+// GetUserFeed only considers questions matching no follow within feedWindow.
+func (r *MongoRepository) GetUserFeed(ctx context.Context, query models.FeedQuery) (*models.FeedPage, error) {
+	tags, users, err := r.followedTargets(ctx, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	match := bson.M{
+		"created_at": bson.M{"$lte": query.AsOf},
+		"user_id":    bson.M{"$ne": query.UserID},
+		"deleted_at": nil,
+		"is_hidden":  bson.M{"$ne": true},
+		"$or": []bson.M{
+			{"tags": bson.M{"$in": tags}},
+			{"user_id": bson.M{"$in": users}},
+			{"created_at": bson.M{"$gte": query.AsOf.Add(-feedWindow)}},
+		},
+	}
+	if len(query.Tags) > 0 {
+		match["tags"] = bson.M{"$in": query.Tags}
+	}
+	if query.UnansweredOnly {
+		match["is_answered"] = false
+	}
+
+	ageDays := bson.M{"$divide": bson.A{
+		bson.M{"$subtract": bson.A{query.AsOf, "$created_at"}},
+		float64(24 * time.Hour / time.Millisecond),
+	}}
+	score := bson.M{"$add": bson.A{
+		bson.M{"$multiply": bson.A{
+			feedTagWeight,
+			bson.M{"$size": bson.M{"$setIntersection": bson.A{bson.M{"$ifNull": bson.A{"$tags", bson.A{}}}, tags}}},
+		}},
+		bson.M{"$cond": bson.A{bson.M{"$in": bson.A{"$user_id", users}}, feedAuthorWeight, 0}},
+		bson.M{"$cond": bson.A{"$is_answered", 0, feedUnansweredWeight}},
+		bson.M{"$divide": bson.A{feedRecencyWeight, bson.M{"$add": bson.A{1, ageDays}}}},
+	}}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$addFields", Value: bson.M{"feed_score": score}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "feed_score", Value: -1},
+			{Key: "created_at", Value: -1},
+			{Key: "_id", Value: -1},
+		}}},
+		{{Key: "$skip", Value: int64(query.Offset)}},
+		{{Key: "$limit", Value: int64(query.Limit) + 1}},
+		{{Key: "$project", Value: bson.M{"feed_score": 0}}},
+	}
+
+	cursor, err := r.questions.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var questions []models.Question
+	if err = cursor.All(ctx, &questions); err != nil {
+		return nil, dbError(err)
+	}
+
+	if len(questions) > query.Limit {
+		return &models.FeedPage{Questions: questions[:query.Limit], HasMore: true}, nil
+	}
+
+	return &models.FeedPage{Questions: questions}, nil
+}
@@ -0,0 +1,329 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+// answerIDs parses the IDs that address an answer of a question.
+func answerIDs(questionID, answerID string) (primitive.ObjectID, primitive.ObjectID, error) {
+	qID, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, err
+	}
+
+	aID, err := mongodb.ParseID("answer_id", answerID)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, err
+	}
+
+	return qID, aID, nil
+}
+
+// anyAnswer returns an answer of a question, including deleted answers.
+func (r *Repository) anyAnswer(questionID, answerID primitive.ObjectID) (*models.Answer, bool) {
+	a, ok := r.answers[answerID]
+	if !ok || a.QuestionID != questionID {
+		return nil, false
+	}
+	return a, true
+}
+
+// liveAnswer is anyAnswer leaving out deleted answers.
+func (r *Repository) liveAnswer(questionID, answerID primitive.ObjectID) (*models.Answer, bool) {
+	a, ok := r.anyAnswer(questionID, answerID)
+	if !ok || a.DeletedAt != nil {
+		return nil, false
+	}
+	return a, true
+}
+
+func (r *Repository) GetAnswersByQuestionID(ctx context.Context, questionID string) ([]models.Answer, error) {
+	qID, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	answers := []models.Answer{}
+	for _, a := range r.answers {
+		if a.QuestionID == qID && a.DeletedAt == nil && !a.IsHidden {
+			answers = append(answers, *clone(a))
+		}
+	}
+
+	sort.Slice(answers, func(i, j int) bool {
+		return before(answers[i].CreatedAt, answers[i].ID, answers[j].CreatedAt, answers[j].ID)
+	})
+	return answers, nil
+}
+
+func (r *Repository) GetAnswerByID(ctx context.Context, questionID, answerID string) (*models.Answer, error) {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	a, ok := r.liveAnswer(qID, aID)
+	if !ok {
+		return nil, errs.NotFoundf("answer not found")
+	}
+	return clone(a), nil
+}
+
+func (r *Repository) GetAnswerOwnerID(ctx context.Context, questionID, answerID string) (string, error) {
+	answer, err := r.GetAnswerByID(ctx, questionID, answerID)
+	if err != nil {
+		return "", err
+	}
+	return answer.UserID, nil
+}
+
+func (r *Repository) PostAnswer(ctx context.Context, questionID string, answer *models.Answer) error {
+	qID, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, ok := r.liveQuestion(qID)
+	if !ok {
+		return errs.NotFoundf("question not found")
+	}
+	q.AnswerCount++
+
+	answer.ID = primitive.NewObjectID()
+	answer.QuestionID = qID
+	answer.CreatedAt = r.now()
+	if answer.Vote == nil {
+		answer.Vote = []models.Vote{}
+	}
+	if answer.Flags == nil {
+		answer.Flags = []models.Flag{}
+	}
+
+	r.answers[answer.ID] = clone(answer)
+	return nil
+}
+
+func (r *Repository) GetDeletedAnswer(ctx context.Context, questionID, answerID string) (*models.Answer, error) {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	a, ok := r.anyAnswer(qID, aID)
+	if !ok || a.DeletedAt == nil {
+		return nil, errs.NotFoundf("deleted answer not found")
+	}
+	return clone(a), nil
+}
+
+func (r *Repository) DeleteAnswer(ctx context.Context, questionID, answerID, deletedBy, reason string) error {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.liveAnswer(qID, aID)
+	if !ok {
+		return errs.NotFoundf("answer not found")
+	}
+
+	now := r.now()
+	a.DeletedAt, a.DeletedBy, a.DeleteReason = &now, deletedBy, reason
+
+	if q, ok := r.questions[qID]; ok {
+		q.AnswerCount--
+		if q.AcceptedAnswerID != nil && *q.AcceptedAnswerID == aID {
+			q.AcceptedAnswerID = nil
+			q.IsAnswered = false
+		}
+	}
+	return nil
+}
+
+func (r *Repository) RestoreAnswer(ctx context.Context, questionID, answerID string) (*models.Answer, error) {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.anyAnswer(qID, aID)
+	if !ok || a.DeletedAt == nil || a.DeletedWithQuestion {
+		return nil, errs.NotFoundf("deleted answer not found")
+	}
+	a.DeletedAt, a.DeletedBy, a.DeleteReason = nil, "", ""
+
+	if q, ok := r.questions[qID]; ok {
+		q.AnswerCount++
+	}
+	return clone(a), nil
+}
+
+func (r *Repository) FlagAnswer(ctx context.Context, questionID, answerID string, flag models.Flag, hide models.AutoHide) error {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.liveAnswer(qID, aID)
+	if !ok {
+		return errs.NotFoundf("answer not found")
+	}
+	return r.addFlag(answerPost(a), flag, hide)
+}
+
+func (r *Repository) GetFlaggedAnswers(ctx context.Context, page models.Page) (*models.AnswerPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var answers []models.Answer
+	for _, a := range r.answers {
+		if a.IsFlagged && a.DeletedAt == nil {
+			answers = append(answers, *clone(a))
+		}
+	}
+
+	total := int64(len(answers))
+	answers, next := paginate(answers, page, func(a models.Answer) models.Cursor {
+		return models.Cursor{CreatedAt: a.CreatedAt, ID: a.ID}
+	})
+	return &models.AnswerPage{Answers: answers, Next: next, Total: total}, nil
+}
+
+func (r *Repository) HasUserVotedOnAnswer(ctx context.Context, questionID, answerID, userID string) (bool, string, error) {
+	answer, err := r.GetAnswerByID(ctx, questionID, answerID)
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, vote := range answer.Vote {
+		if vote.UserID == userID {
+			return true, vote.VoteType, nil
+		}
+	}
+
+	return false, "", nil
+}
+
+func (r *Repository) UpvoteAnswer(ctx context.Context, questionID, answerID, userID string) error {
+	return r.castVote(questionID, answerID, userID, models.VoteTypeUpvote)
+}
+
+func (r *Repository) DownvoteAnswer(ctx context.Context, questionID, answerID, userID string) error {
+	return r.castVote(questionID, answerID, userID, models.VoteTypeDownvote)
+}
+
+// castVote records a first vote, switches a vote of the opposite type or
+// reports that the user has already voted this way.
+func (r *Repository) castVote(questionID, answerID, userID, voteType string) error {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.liveAnswer(qID, aID)
+	if !ok {
+		return errs.NotFoundf("answer not found")
+	}
+
+	for i := range a.Vote {
+		vote := &a.Vote[i]
+		if vote.UserID != userID {
+			continue
+		}
+		if vote.VoteType == voteType {
+			return errs.AlreadyExistsf("already %sd", voteType)
+		}
+
+		*voteCounter(a, vote.VoteType)--
+		*voteCounter(a, voteType)++
+		vote.VoteType = voteType
+		vote.VotedAt = r.now()
+		return nil
+	}
+
+	*voteCounter(a, voteType)++
+	a.Vote = append(a.Vote, models.Vote{
+		UserID:   userID,
+		VoteType: voteType,
+		VotedAt:  r.now(),
+	})
+	return nil
+}
+
+func (r *Repository) ToggleVote(ctx context.Context, questionID, answerID, userID, voteType string) (*models.Answer, error) {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.liveAnswer(qID, aID)
+	if !ok {
+		return nil, errs.NotFoundf("answer not found")
+	}
+
+	for i := range a.Vote {
+		vote := &a.Vote[i]
+		if vote.UserID != userID {
+			continue
+		}
+
+		*voteCounter(a, vote.VoteType)--
+		if vote.VoteType == voteType {
+			a.Vote = append(a.Vote[:i:i], a.Vote[i+1:]...)
+		} else {
+			*voteCounter(a, voteType)++
+			vote.VoteType = voteType
+			vote.VotedAt = r.now()
+		}
+		return clone(a), nil
+	}
+
+	*voteCounter(a, voteType)++
+	a.Vote = append(a.Vote, models.Vote{
+		UserID:   userID,
+		VoteType: voteType,
+		VotedAt:  r.now(),
+	})
+	return clone(a), nil
+}
+
+func voteCounter(a *models.Answer, voteType string) *int {
+	if voteType == models.VoteTypeDownvote {
+		return &a.Downvotes
+	}
+	return &a.Upvotes
+}
@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+func (r *Repository) RecordAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event.ID = primitive.NewObjectID()
+	event.CreatedAt = r.now()
+	r.audit = append(r.audit, clone(event))
+	return nil
+}
+
+func (r *Repository) ListAuditEvents(ctx context.Context, query models.AuditQuery, page models.Page) (*models.AuditPage, error) {
+	matches := func(want, got string) bool {
+		return want == "" || want == got
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var events []models.AuditEvent
+	for _, event := range r.audit {
+		if !matches(query.ActorID, event.ActorID) || !matches(query.Action, event.Action) ||
+			!matches(query.TargetType, event.TargetType) || !matches(query.TargetID, event.TargetID) {
+			continue
+		}
+		if event.CreatedAt.Before(query.Since) || (!query.Until.IsZero() && !event.CreatedAt.Before(query.Until)) {
+			continue
+		}
+		events = append(events, *clone(event))
+	}
+
+	events, next := paginate(events, page, func(e models.AuditEvent) models.Cursor {
+		return models.Cursor{CreatedAt: e.CreatedAt, ID: e.ID}
+	})
+	return &models.AuditPage{Events: events, Next: next}, nil
+}
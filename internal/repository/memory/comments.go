@@ -0,0 +1,174 @@
+package memory
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+// adjustCommentCount adds delta to the comment_count of the live question or
+// answer a comment belongs to, and reports whether there was one.
+func (r *Repository) adjustCommentCount(comment *models.Comment, delta int) bool {
+	if comment.TargetType == models.TargetAnswer {
+		a, ok := r.liveAnswer(comment.QuestionID, comment.TargetID)
+		if ok {
+			a.CommentCount += delta
+		}
+		return ok
+	}
+
+	q, ok := r.liveQuestion(comment.QuestionID)
+	if ok {
+		q.CommentCount += delta
+	}
+	return ok
+}
+
+// liveComment returns the stored comment with the given ID unless it is
+// missing or deleted.
+func (r *Repository) liveComment(id primitive.ObjectID) (*models.Comment, bool) {
+	c, ok := r.comments[id]
+	if !ok || c.DeletedAt != nil {
+		return nil, false
+	}
+	return c, true
+}
+
+func (r *Repository) PostComment(ctx context.Context, comment *models.Comment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	comment.Ancestors = []primitive.ObjectID{}
+	if comment.ParentID != nil {
+		parent, ok := r.liveComment(*comment.ParentID)
+		if !ok {
+			return errs.NotFoundf("comment not found")
+		}
+
+		if parent.TargetType != comment.TargetType || parent.TargetID != comment.TargetID {
+			return errs.InvalidField("parent_id", "parent comment belongs to a different post")
+		}
+
+		comment.Ancestors = append(append(comment.Ancestors, parent.Ancestors...), parent.ID)
+	}
+
+	if !r.adjustCommentCount(comment, 1) {
+		return errs.NotFoundf("%s not found", comment.TargetType)
+	}
+
+	comment.ID = primitive.NewObjectID()
+	comment.CreatedAt = r.now()
+	comment.UpdatedAt = comment.CreatedAt
+	if comment.Flags == nil {
+		comment.Flags = []models.Flag{}
+	}
+
+	r.comments[comment.ID] = clone(comment)
+	return nil
+}
+
+func (r *Repository) GetCommentByID(ctx context.Context, commentID string) (*models.Comment, error) {
+	id, err := mongodb.ParseID("comment_id", commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	comment, ok := r.liveComment(id)
+	if !ok {
+		return nil, errs.NotFoundf("comment not found")
+	}
+	return clone(comment), nil
+}
+
+func (r *Repository) EditComment(ctx context.Context, commentID, body string) (*models.Comment, error) {
+	id, err := mongodb.ParseID("comment_id", commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	comment, ok := r.liveComment(id)
+	if !ok {
+		return nil, errs.NotFoundf("comment not found")
+	}
+
+	comment.Body = body
+	comment.UpdatedAt = r.now()
+	return clone(comment), nil
+}
+
+func (r *Repository) DeleteComment(ctx context.Context, commentID string) error {
+	id, err := mongodb.ParseID("comment_id", commentID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	comment, ok := r.liveComment(id)
+	if !ok {
+		return errs.NotFoundf("comment not found")
+	}
+
+	deletedAt := r.now()
+	comment.Body = models.DeletedCommentBody
+	comment.DeletedAt = &deletedAt
+	r.adjustCommentCount(comment, -1)
+	return nil
+}
+
+func (r *Repository) ListComments(ctx context.Context, targetType, targetID string, page models.Page) (*models.CommentPage, error) {
+	id, err := mongodb.ParseID("target_id", targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if targetType != models.TargetQuestion && targetType != models.TargetAnswer {
+		return nil, errs.InvalidField("target_type", "must be question or answer")
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.livePost(targetType, id); !ok {
+		return nil, errs.NotFoundf("%s not found", targetType)
+	}
+
+	var comments []models.Comment
+	for _, comment := range r.comments {
+		if comment.TargetType == targetType && comment.TargetID == id && !comment.IsHidden {
+			comments = append(comments, *clone(comment))
+		}
+	}
+
+	comments, next := paginate(comments, page, func(c models.Comment) models.Cursor {
+		return models.Cursor{CreatedAt: c.CreatedAt, ID: c.ID}
+	})
+	return &models.CommentPage{Comments: comments, Next: next}, nil
+}
+
+func (r *Repository) FlagComment(ctx context.Context, commentID string, flag models.Flag, hide models.AutoHide) error {
+	id, err := mongodb.ParseID("comment_id", commentID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	comment, ok := r.liveComment(id)
+	if !ok {
+		return errs.NotFoundf("comment not found")
+	}
+	return r.addFlag(commentPost(comment), flag, hide)
+}
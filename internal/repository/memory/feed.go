@@ -0,0 +1,153 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// The feed window and weights are those of the MongoDB feed.
+const (
+	feedWindow = 30 * 24 * time.Hour
+
+	feedTagWeight        = 3.0
+	feedAuthorWeight     = 5.0
+	feedUnansweredWeight = 1.0
+	feedRecencyWeight    = 4.0
+)
+
+func (r *Repository) Follow(ctx context.Context, follow *models.Follow) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, f := range r.follows {
+		if f.UserID == follow.UserID && f.TargetType == follow.TargetType && f.Target == follow.Target {
+			return errs.AlreadyExistsf("already following %s", follow.TargetType)
+		}
+	}
+
+	follow.ID = primitive.NewObjectID()
+	follow.CreatedAt = r.now()
+	r.follows = append(r.follows, clone(follow))
+	return nil
+}
+
+func (r *Repository) Unfollow(ctx context.Context, userID, targetType, target string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, f := range r.follows {
+		if f.UserID == userID && f.TargetType == targetType && f.Target == target {
+			r.follows = append(r.follows[:i:i], r.follows[i+1:]...)
+			return nil
+		}
+	}
+
+	return errs.NotFoundf("not following %s", targetType)
+}
+
+func (r *Repository) ListFollows(ctx context.Context, userID, targetType string, page models.Page) (*models.FollowPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var follows []models.Follow
+	for _, f := range r.follows {
+		if f.UserID == userID && (targetType == "" || f.TargetType == targetType) {
+			follows = append(follows, *clone(f))
+		}
+	}
+
+	follows, next := paginate(follows, page, func(f models.Follow) models.Cursor {
+		return models.Cursor{CreatedAt: f.CreatedAt, ID: f.ID}
+	})
+	return &models.FollowPage{Follows: follows, Next: next}, nil
+}
+
+// GetUserFeed scores questions the same way as the MongoDB feed.
+func (r *Repository) GetUserFeed(ctx context.Context, query models.FeedQuery) (*models.FeedPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tags, users := make(map[string]bool), make(map[string]bool)
+	for _, f := range r.follows {
+		if f.UserID != query.UserID {
+			continue
+		}
+		switch f.TargetType {
+		case models.FollowTag:
+			tags[f.Target] = true
+		case models.FollowUser:
+			users[f.Target] = true
+		}
+	}
+
+	type scored struct {
+		question *models.Question
+		score    float64
+	}
+	var ranked []scored
+	windowStart := query.AsOf.Add(-feedWindow)
+
+	for _, q := range r.questions {
+		if q.CreatedAt.After(query.AsOf) || q.UserID == query.UserID || q.DeletedAt != nil || q.IsHidden {
+			continue
+		}
+		if len(query.Tags) > 0 && !containsAny(q.Tags, query.Tags) {
+			continue
+		}
+		if query.UnansweredOnly && q.IsAnswered {
+			continue
+		}
+
+		followedTags := make(map[string]bool)
+		for _, tag := range q.Tags {
+			if tags[tag] {
+				followedTags[tag] = true
+			}
+		}
+		if len(followedTags) == 0 && !users[q.UserID] && q.CreatedAt.Before(windowStart) {
+			continue
+		}
+
+		ageDays := float64(query.AsOf.Sub(q.CreatedAt).Milliseconds()) / float64(24*time.Hour/time.Millisecond)
+		score := feedTagWeight*float64(len(followedTags)) + feedRecencyWeight/(1+ageDays)
+		if users[q.UserID] {
+			score += feedAuthorWeight
+		}
+		if !q.IsAnswered {
+			score += feedUnansweredWeight
+		}
+		ranked = append(ranked, scored{question: q, score: score})
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		if !a.question.CreatedAt.Equal(b.question.CreatedAt) {
+			return a.question.CreatedAt.After(b.question.CreatedAt)
+		}
+		return bytes.Compare(a.question.ID[:], b.question.ID[:]) > 0
+	})
+
+	if query.Offset >= len(ranked) {
+		return &models.FeedPage{}, nil
+	}
+	ranked = ranked[query.Offset:]
+
+	result := &models.FeedPage{}
+	if len(ranked) > query.Limit {
+		ranked, result.HasMore = ranked[:query.Limit], true
+	}
+	for _, entry := range ranked {
+		result.Questions = append(result.Questions, *clone(entry.question))
+	}
+	return result, nil
+}
@@ -0,0 +1,425 @@
+// Package memory is an in-memory Repository for tests and local development.
+// It follows the MongoDB repository in ordering, errors and flag and vote
+// semantics, and documents are copied in and out through BSON so that they
+// come back exactly as MongoDB would return them, with timestamps rounded to
+// the millisecond. Nothing is kept across restarts.
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+var _ mongodb.Repository = (*Repository)(nil)
+
+// Repository keeps every collection in maps guarded by a single lock.
+type Repository struct {
+	mu        sync.RWMutex
+	now       func() time.Time
+	questions map[primitive.ObjectID]*models.Question
+	answers   map[primitive.ObjectID]*models.Answer
+	tags      map[string]*models.Tag
+	revisions []*models.Revision
+	comments  map[primitive.ObjectID]*models.Comment
+	follows   []*models.Follow
+	warnings  []*models.Warning
+	audit     []*models.AuditEvent
+}
+
+func New() *Repository {
+	return &Repository{
+		now: func() time.Time {
+			return time.Now().Truncate(time.Millisecond)
+		},
+		questions: make(map[primitive.ObjectID]*models.Question),
+		answers:   make(map[primitive.ObjectID]*models.Answer),
+		tags:      make(map[string]*models.Tag),
+		comments:  make(map[primitive.ObjectID]*models.Comment),
+	}
+}
+
+// clone copies v through BSON, the way a document makes a round trip
+// through MongoDB.
+func clone[T any](v *T) *T {
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	var c T
+	if err := bson.Unmarshal(raw, &c); err != nil {
+		panic(err)
+	}
+	return &c
+}
+
+func containsAny(values, wanted []string) bool {
+	for _, value := range values {
+		for _, w := range wanted {
+			if value == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Ping always succeeds.
+func (r *Repository) Ping(ctx context.Context) error {
+	return nil
+}
+
+// Close is a no-op. It is there so that callers can close either backend.
+func (r *Repository) Close(ctx context.Context) error {
+	return nil
+}
+
+func (r *Repository) PostQuestion(ctx context.Context, question *models.Question) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	question.ID = primitive.NewObjectID()
+	question.CreatedAt = r.now()
+	question.IsAnswered = false
+	question.AnswerCount = 0
+
+	r.questions[question.ID] = clone(question)
+	return nil
+}
+
+// listQuestions returns a page of the live, visible questions that match.
+func (r *Repository) listQuestions(match func(q *models.Question) bool, page models.Page) *models.QuestionPage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var questions []models.Question
+	for _, q := range r.questions {
+		if q.DeletedAt == nil && !q.IsHidden && match(q) {
+			questions = append(questions, *clone(q))
+		}
+	}
+
+	questions, next := paginate(questions, page, func(q models.Question) models.Cursor {
+		return models.Cursor{CreatedAt: q.CreatedAt, ID: q.ID}
+	})
+	return &models.QuestionPage{Questions: questions, Next: next}
+}
+
+func (r *Repository) GetQuestionsByUserID(ctx context.Context, userID string, page models.Page) (*models.QuestionPage, error) {
+	return r.listQuestions(func(q *models.Question) bool {
+		return q.UserID == userID
+	}, page), nil
+}
+
+func (r *Repository) GetQuestionsByTags(ctx context.Context, tags []string, page models.Page) (*models.QuestionPage, error) {
+	return r.listQuestions(func(q *models.Question) bool {
+		return containsAny(q.Tags, tags)
+	}, page), nil
+}
+
+func (r *Repository) GetQuestionsByWord(ctx context.Context, word string, page models.Page) (*models.QuestionPage, error) {
+	terms := searchTerms(word)
+	return r.listQuestions(func(q *models.Question) bool {
+		return termScore(q.Question, terms)+termScore(q.Details, terms) > 0
+	}, page), nil
+}
+
+// liveQuestion returns the question unless it is missing or deleted.
+func (r *Repository) liveQuestion(id primitive.ObjectID) (*models.Question, bool) {
+	q, ok := r.questions[id]
+	if !ok || q.DeletedAt != nil {
+		return nil, false
+	}
+	return q, true
+}
+
+func (r *Repository) DeleteQuestion(ctx context.Context, questionID, deletedBy, reason string) error {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, ok := r.liveQuestion(id)
+	if !ok {
+		return errs.NotFoundf("question not found")
+	}
+
+	now := r.now()
+	q.DeletedAt, q.DeletedBy, q.DeleteReason = &now, deletedBy, reason
+
+	for _, a := range r.answers {
+		if a.QuestionID == id && a.DeletedAt == nil {
+			a.DeletedAt, a.DeletedBy, a.DeletedWithQuestion = &now, deletedBy, true
+		}
+	}
+	return nil
+}
+
+func (r *Repository) GetDeletedQuestion(ctx context.Context, questionID string) (*models.Question, error) {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	q, ok := r.questions[id]
+	if !ok || q.DeletedAt == nil {
+		return nil, errs.NotFoundf("deleted question not found")
+	}
+	return clone(q), nil
+}
+
+func (r *Repository) RestoreQuestion(ctx context.Context, questionID string) (*models.Question, error) {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, ok := r.questions[id]
+	if !ok || q.DeletedAt == nil {
+		return nil, errs.NotFoundf("deleted question not found")
+	}
+	q.DeletedAt, q.DeletedBy, q.DeleteReason = nil, "", ""
+
+	for _, a := range r.answers {
+		if a.QuestionID == id && a.DeletedWithQuestion {
+			a.DeletedAt, a.DeletedBy, a.DeletedWithQuestion = nil, "", false
+		}
+	}
+	return clone(q), nil
+}
+
+func (r *Repository) GetQuestionByID(ctx context.Context, questionID string) (*models.Question, error) {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	q, ok := r.liveQuestion(id)
+	if !ok {
+		return nil, errs.NotFoundf("question not found")
+	}
+	return clone(q), nil
+}
+
+func (r *Repository) GetUserIDFromQuestionID(ctx context.Context, questionID string) (string, error) {
+	q, err := r.GetQuestionByID(ctx, questionID)
+	if err != nil {
+		return "", err
+	}
+	return q.UserID, nil
+}
+
+func (r *Repository) FlagQuestion(ctx context.Context, questionID string, flag models.Flag, hide models.AutoHide) error {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, ok := r.liveQuestion(id)
+	if !ok {
+		return errs.NotFoundf("question not found")
+	}
+	return r.addFlag(questionPost(q), flag, hide)
+}
+
+func (r *Repository) MarkQuestionAsAnswered(ctx context.Context, questionID string) error {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, ok := r.liveQuestion(id)
+	if !ok {
+		return errs.NotFoundf("question not found")
+	}
+	if q.AcceptedAnswerID == nil {
+		return errs.FailedPreconditionf("question has no accepted answer; accept one with AcceptAnswer")
+	}
+	return nil
+}
+
+func (r *Repository) AcceptAnswer(ctx context.Context, questionID, answerID string) error {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.liveAnswer(qID, aID)
+	if !ok {
+		return errs.NotFoundf("answer not found")
+	}
+
+	q, ok := r.liveQuestion(a.QuestionID)
+	if !ok {
+		return errs.NotFoundf("question not found")
+	}
+	q.AcceptedAnswerID = &a.ID
+	q.IsAnswered = true
+	return nil
+}
+
+func (r *Repository) UnacceptAnswer(ctx context.Context, questionID string) error {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, ok := r.liveQuestion(id)
+	if !ok {
+		return errs.NotFoundf("question not found")
+	}
+	if q.AcceptedAnswerID == nil {
+		return errs.NotFoundf("question has no accepted answer")
+	}
+	q.AcceptedAnswerID = nil
+	q.IsAnswered = false
+	return nil
+}
+
+func (r *Repository) GetFlaggedQuestions(ctx context.Context, page models.Page) (*models.QuestionPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var questions []models.Question
+	for _, q := range r.questions {
+		if q.IsFlagged && q.DeletedAt == nil {
+			questions = append(questions, *clone(q))
+		}
+	}
+
+	total := int64(len(questions))
+	questions, next := paginate(questions, page, func(q models.Question) models.Cursor {
+		return models.Cursor{CreatedAt: q.CreatedAt, ID: q.ID}
+	})
+	return &models.QuestionPage{Questions: questions, Next: next, Total: total}, nil
+}
+
+func (r *Repository) AddTag(ctx context.Context, tag *models.Tag) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tags[tag.Name]; ok {
+		return errs.AlreadyExistsf("tag already exists")
+	}
+
+	tag.ID = primitive.NewObjectID()
+	tag.CreatedAt = r.now()
+	r.tags[tag.Name] = clone(tag)
+	return nil
+}
+
+func (r *Repository) RemoveTag(ctx context.Context, tagName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tags[tagName]; !ok {
+		return errs.NotFoundf("tag not found")
+	}
+	delete(r.tags, tagName)
+	return nil
+}
+
+func (r *Repository) UpdateTag(ctx context.Context, tagName, description string) (*models.Tag, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tag, ok := r.tags[tagName]
+	if !ok {
+		return nil, errs.NotFoundf("tag not found")
+	}
+	tag.Description = description
+	return clone(tag), nil
+}
+
+func (r *Repository) GetTag(ctx context.Context, tagName string) (*models.Tag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tag, ok := r.tags[tagName]
+	if !ok {
+		return nil, errs.NotFoundf("tag not found")
+	}
+	return clone(tag), nil
+}
+
+func (r *Repository) ListTags(ctx context.Context, page models.Page) (*models.TagPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tags := make([]models.Tag, 0, len(r.tags))
+	for _, tag := range r.tags {
+		tags = append(tags, *clone(tag))
+	}
+
+	tags, next := paginate(tags, page, func(t models.Tag) models.Cursor {
+		return models.Cursor{CreatedAt: t.CreatedAt, ID: t.ID}
+	})
+	return &models.TagPage{Tags: tags, Next: next}, nil
+}
+
+// GetTagsByNames returns the tags that exist, in the order they were added.
+func (r *Repository) GetTagsByNames(ctx context.Context, tagNames []string) ([]models.Tag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var tags []models.Tag
+	seen := make(map[string]bool, len(tagNames))
+	for _, name := range tagNames {
+		if tag, ok := r.tags[name]; ok && !seen[name] {
+			seen[name] = true
+			tags = append(tags, *clone(tag))
+		}
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return bytes.Compare(tags[i].ID[:], tags[j].ID[:]) < 0
+	})
+	return tags, nil
+}
+
+func (r *Repository) EnsureTags(ctx context.Context, tagNames []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	for _, name := range tagNames {
+		if _, ok := r.tags[name]; !ok {
+			r.tags[name] = &models.Tag{ID: primitive.NewObjectID(), Name: name, CreatedAt: now}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+	"github.com/liju-github/ContentService/internal/repository/memory"
+	"github.com/liju-github/ContentService/internal/repository/repotest"
+)
+
+func TestConformance(t *testing.T) {
+	repotest.Run(t, func(*testing.T) mongodb.Repository {
+		return memory.New()
+	})
+}
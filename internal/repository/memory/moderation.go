@@ -0,0 +1,380 @@
+package memory
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+// moderationTargets lists the kinds of post that can be flagged.
+var moderationTargets = []string{models.TargetQuestion, models.TargetAnswer, models.TargetComment}
+
+// post gives the moderation code uniform access to a stored question, answer
+// or comment.
+type post struct {
+	targetType string
+	id         primitive.ObjectID
+	questionID primitive.ObjectID
+	userID     string
+	body       string
+	deleted    bool
+	flags      *[]models.Flag
+	isFlagged  *bool
+	isHidden   *bool
+	autoHidden *bool
+}
+
+func questionPost(q *models.Question) post {
+	return post{
+		targetType: models.TargetQuestion,
+		id:         q.ID,
+		questionID: q.ID,
+		userID:     q.UserID,
+		body:       q.Question,
+		deleted:    q.DeletedAt != nil,
+		flags:      &q.Flags,
+		isFlagged:  &q.IsFlagged,
+		isHidden:   &q.IsHidden,
+		autoHidden: &q.AutoHidden,
+	}
+}
+
+func answerPost(a *models.Answer) post {
+	return post{
+		targetType: models.TargetAnswer,
+		id:         a.ID,
+		questionID: a.QuestionID,
+		userID:     a.UserID,
+		body:       a.Answer,
+		deleted:    a.DeletedAt != nil,
+		flags:      &a.Flags,
+		isFlagged:  &a.IsFlagged,
+		isHidden:   &a.IsHidden,
+		autoHidden: &a.AutoHidden,
+	}
+}
+
+func commentPost(c *models.Comment) post {
+	return post{
+		targetType: models.TargetComment,
+		id:         c.ID,
+		questionID: c.QuestionID,
+		userID:     c.UserID,
+		body:       c.Body,
+		deleted:    c.DeletedAt != nil,
+		flags:      &c.Flags,
+		isFlagged:  &c.IsFlagged,
+		isHidden:   &c.IsHidden,
+		autoHidden: &c.AutoHidden,
+	}
+}
+
+// checkTarget rejects target types that cannot be flagged.
+func checkTarget(targetType string) error {
+	for _, target := range moderationTargets {
+		if targetType == target {
+			return nil
+		}
+	}
+	return errs.InvalidField("target_type", "must be question, answer or comment")
+}
+
+// posts returns every stored post of targetType, deleted or not.
+func (r *Repository) posts(targetType string) ([]post, error) {
+	if err := checkTarget(targetType); err != nil {
+		return nil, err
+	}
+
+	var posts []post
+	switch targetType {
+	case models.TargetQuestion:
+		for _, q := range r.questions {
+			posts = append(posts, questionPost(q))
+		}
+	case models.TargetAnswer:
+		for _, a := range r.answers {
+			posts = append(posts, answerPost(a))
+		}
+	case models.TargetComment:
+		for _, c := range r.comments {
+			posts = append(posts, commentPost(c))
+		}
+	}
+	return posts, nil
+}
+
+// livePost returns the post of a checked targetType with the given ID
+// unless it is missing or deleted.
+func (r *Repository) livePost(targetType string, id primitive.ObjectID) (post, bool) {
+	var p post
+	switch targetType {
+	case models.TargetQuestion:
+		q, ok := r.questions[id]
+		if !ok {
+			return p, false
+		}
+		p = questionPost(q)
+	case models.TargetAnswer:
+		a, ok := r.answers[id]
+		if !ok {
+			return p, false
+		}
+		p = answerPost(a)
+	default:
+		c, ok := r.comments[id]
+		if !ok {
+			return p, false
+		}
+		p = commentPost(c)
+	}
+	return p, !p.deleted
+}
+
+// openFlags returns copies of the post's flags that no moderator has
+// resolved yet.
+func (p post) openFlags() []models.Flag {
+	open := []models.Flag{}
+	for _, flag := range *p.flags {
+		if flag.IsOpen() {
+			open = append(open, *clone(&flag))
+		}
+	}
+	return open
+}
+
+// moderationItem describes the post with its open flags.
+func (p post) moderationItem() models.ModerationItem {
+	item := models.ModerationItem{
+		TargetType: p.targetType,
+		TargetID:   p.id,
+		QuestionID: p.questionID,
+		UserID:     p.userID,
+		Body:       p.body,
+		IsHidden:   *p.isHidden,
+		AutoHidden: *p.autoHidden,
+		Flags:      p.openFlags(),
+	}
+	for _, flag := range item.Flags {
+		if flag.CreatedAt.After(item.FlaggedAt) {
+			item.FlaggedAt = flag.CreatedAt
+		}
+	}
+	return item
+}
+
+// addFlag adds flag to the post unless its user already has an open flag
+// there, and hides the post if that takes it over hide's limits. Posts a
+// moderator already hid stay hidden without becoming auto-hidden.
+func (r *Repository) addFlag(p post, flag models.Flag, hide models.AutoHide) error {
+	for _, f := range *p.flags {
+		if f.UserID == flag.UserID && f.IsOpen() {
+			return errs.AlreadyExistsf("you have already flagged this %s", p.targetType)
+		}
+	}
+
+	flag.CreatedAt = r.now()
+	*p.flags = append(*p.flags, flag)
+	*p.isFlagged = true
+
+	users := make(map[string]bool)
+	var score float64
+	for _, f := range *p.flags {
+		if !f.IsOpen() {
+			continue
+		}
+		users[f.UserID] = true
+		if f.Weight == 0 {
+			score++
+		} else {
+			score += f.Weight
+		}
+	}
+
+	overLimit := (hide.Flags > 0 && len(users) >= hide.Flags) || (hide.Score > 0 && score >= hide.Score)
+	if overLimit && !*p.isHidden {
+		*p.isHidden, *p.autoHidden = true, true
+	}
+	return nil
+}
+
+func (r *Repository) ListModerationQueue(ctx context.Context, targetType, reason string, page models.Page) (*models.ModerationPage, error) {
+	targets := moderationTargets
+	if targetType != "" {
+		targets = []string{targetType}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var items []models.ModerationItem
+	for _, target := range targets {
+		posts, err := r.posts(target)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range posts {
+			if p.deleted || !*p.isFlagged {
+				continue
+			}
+			item := p.moderationItem()
+			if len(item.Flags) > 0 && (reason == "" || hasReason(item.Flags, reason)) {
+				items = append(items, item)
+			}
+		}
+	}
+
+	items, next := paginate(items, page, func(item models.ModerationItem) models.Cursor {
+		return models.Cursor{CreatedAt: item.FlaggedAt, ID: item.TargetID}
+	})
+	return &models.ModerationPage{Items: items, Next: next}, nil
+}
+
+func hasReason(flags []models.Flag, reason string) bool {
+	for _, flag := range flags {
+		if flag.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Repository) CountFlagsByReason(ctx context.Context, query models.FlagCountQuery) (map[string]int64, error) {
+	targets := moderationTargets
+	if query.TargetType != "" {
+		targets = []string{query.TargetType}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make(map[string]int64)
+	for _, target := range targets {
+		posts, err := r.posts(target)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range posts {
+			for _, flag := range *p.flags {
+				if flag.CreatedAt.Before(query.Since) || (!query.IncludeResolved && !flag.IsOpen()) {
+					continue
+				}
+				counts[flag.Reason]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+func (r *Repository) GetModerationItem(ctx context.Context, targetType, targetID string) (*models.ModerationItem, error) {
+	if err := checkTarget(targetType); err != nil {
+		return nil, err
+	}
+
+	id, err := mongodb.ParseID("target_id", targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.livePost(targetType, id)
+	if !ok {
+		return nil, errs.NotFoundf("%s not found", targetType)
+	}
+
+	item := p.moderationItem()
+	return &item, nil
+}
+
+func (r *Repository) ResolveFlags(ctx context.Context, targetType, targetID string, resolution models.FlagResolution) error {
+	if err := checkTarget(targetType); err != nil {
+		return err
+	}
+
+	id, err := mongodb.ParseID("target_id", targetID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.livePost(targetType, id)
+	if !ok || len(p.openFlags()) == 0 {
+		return errs.NotFoundf("%s has no open flags", targetType)
+	}
+
+	now := r.now()
+	for i := range *p.flags {
+		flag := &(*p.flags)[i]
+		if flag.IsOpen() {
+			flag.Resolution = resolution.Resolution
+			flag.ResolvedBy = resolution.ModeratorID
+			flag.ResolvedAt = &now
+			flag.ResolutionNote = resolution.Note
+		}
+	}
+	*p.isFlagged = false
+
+	switch {
+	case resolution.Resolution == models.FlagHidden:
+		*p.isHidden, *p.autoHidden = true, false
+	case *p.autoHidden:
+		*p.isHidden, *p.autoHidden = false, false
+	}
+	return nil
+}
+
+func (r *Repository) ReopenFlags(ctx context.Context, targetType, targetID string, resolution models.FlagResolution) error {
+	if err := checkTarget(targetType); err != nil {
+		return err
+	}
+
+	id, err := mongodb.ParseID("target_id", targetID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// A delete that failed part way may have removed the post, so deleted
+	// posts are reopened too
+	p, _ := r.livePost(targetType, id)
+	if p.flags == nil {
+		return errs.NotFoundf("%s not found", targetType)
+	}
+
+	reopened := false
+	for i := range *p.flags {
+		flag := &(*p.flags)[i]
+		if !flag.IsOpen() && flag.Resolution == resolution.Resolution &&
+			flag.ResolvedBy == resolution.ModeratorID && flag.ResolutionNote == resolution.Note {
+			flag.Resolution, flag.ResolvedBy, flag.ResolvedAt, flag.ResolutionNote = "", "", nil, ""
+			reopened = true
+		}
+	}
+	if !reopened {
+		return errs.NotFoundf("%s has no flags resolved that way", targetType)
+	}
+
+	*p.isFlagged = true
+	return nil
+}
+
+func (r *Repository) AddWarning(ctx context.Context, warning *models.Warning) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	warning.ID = primitive.NewObjectID()
+	warning.CreatedAt = r.now()
+	r.warnings = append(r.warnings, clone(warning))
+	return nil
+}
@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// before reports whether (t1, id1) sorts before (t2, id2) in ascending
+// (created_at, _id) order.
+func before(t1 time.Time, id1 primitive.ObjectID, t2 time.Time, id2 primitive.ObjectID) bool {
+	if !t1.Equal(t2) {
+		return t1.Before(t2)
+	}
+	return bytes.Compare(id1[:], id2[:]) < 0
+}
+
+// paginate sorts items newest first, skips those up to the page cursor and
+// returns one page along with the cursor of the next one, the same way the
+// MongoDB listings do.
+func paginate[T any](items []T, page models.Page, cursorOf func(T) models.Cursor) ([]T, *models.Cursor) {
+	sort.Slice(items, func(i, j int) bool {
+		ci, cj := cursorOf(items[i]), cursorOf(items[j])
+		return before(cj.CreatedAt, cj.ID, ci.CreatedAt, ci.ID)
+	})
+
+	if page.After != nil {
+		after := *page.After
+		start := sort.Search(len(items), func(i int) bool {
+			c := cursorOf(items[i])
+			return before(c.CreatedAt, c.ID, after.CreatedAt, after.ID)
+		})
+		items = items[start:]
+	}
+
+	if len(items) <= page.Limit {
+		return items, nil
+	}
+
+	next := cursorOf(items[page.Limit-1])
+	return items[:page.Limit], &next
+}
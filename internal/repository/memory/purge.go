@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+func (r *Repository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged int64
+	for id, q := range r.questions {
+		if q.DeletedAt == nil || !q.DeletedAt.Before(before) {
+			continue
+		}
+
+		for commentID, comment := range r.comments {
+			if comment.QuestionID == id {
+				delete(r.comments, commentID)
+			}
+		}
+		r.dropRevisions(func(revision *models.Revision) bool {
+			return revision.QuestionID == id
+		})
+		for answerID, a := range r.answers {
+			if a.QuestionID == id {
+				delete(r.answers, answerID)
+				purged++
+			}
+		}
+
+		delete(r.questions, id)
+		purged++
+	}
+
+	for id, a := range r.answers {
+		if a.DeletedAt == nil || !a.DeletedAt.Before(before) || a.DeletedWithQuestion {
+			continue
+		}
+
+		for commentID, comment := range r.comments {
+			if comment.TargetType == models.TargetAnswer && comment.TargetID == id {
+				delete(r.comments, commentID)
+			}
+		}
+		r.dropRevisions(func(revision *models.Revision) bool {
+			return revision.TargetType == models.TargetAnswer && revision.TargetID == id
+		})
+
+		delete(r.answers, id)
+		purged++
+	}
+
+	return purged, nil
+}
+
+// dropRevisions removes the revisions matching drop.
+func (r *Repository) dropRevisions(drop func(*models.Revision) bool) {
+	kept := r.revisions[:0]
+	for _, revision := range r.revisions {
+		if !drop(revision) {
+			kept = append(kept, revision)
+		}
+	}
+	r.revisions = kept
+}
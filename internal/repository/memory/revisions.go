@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+func (r *Repository) EditQuestion(ctx context.Context, questionID string, revision *models.Revision) (*models.Question, error) {
+	qID, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, ok := r.liveQuestion(qID)
+	if !ok {
+		return nil, errs.NotFoundf("question not found")
+	}
+
+	now := r.now()
+	revision.TargetType = models.TargetQuestion
+	revision.TargetID = qID
+	revision.QuestionID = qID
+	revision.CreatedAt = now
+
+	original := &models.Revision{
+		TargetType: models.TargetQuestion,
+		TargetID:   qID,
+		QuestionID: qID,
+		EditorID:   q.UserID,
+		Question:   q.Question,
+		Details:    q.Details,
+		Tags:       q.Tags,
+		CreatedAt:  q.CreatedAt,
+	}
+	r.recordRevision(q.RevisionCount, original, revision)
+
+	q.Question = revision.Question
+	q.Details = revision.Details
+	q.Tags = revision.Tags
+	q.UpdatedAt = now
+	q.LastEditedBy = revision.EditorID
+	q.RevisionCount++
+
+	// Store a copy that does not share Tags with the caller's revision
+	r.questions[qID] = clone(q)
+	return clone(q), nil
+}
+
+func (r *Repository) EditAnswer(ctx context.Context, questionID, answerID string, revision *models.Revision) (*models.Answer, error) {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.liveAnswer(qID, aID)
+	if !ok {
+		return nil, errs.NotFoundf("answer not found")
+	}
+
+	now := r.now()
+	revision.TargetType = models.TargetAnswer
+	revision.TargetID = a.ID
+	revision.QuestionID = a.QuestionID
+	revision.CreatedAt = now
+
+	original := &models.Revision{
+		TargetType: models.TargetAnswer,
+		TargetID:   a.ID,
+		QuestionID: a.QuestionID,
+		EditorID:   a.UserID,
+		Answer:     a.Answer,
+		CreatedAt:  a.CreatedAt,
+	}
+	r.recordRevision(a.RevisionCount, original, revision)
+
+	a.Answer = revision.Answer
+	a.UpdatedAt = now
+	a.LastEditedBy = revision.EditorID
+	a.RevisionCount++
+	return clone(a), nil
+}
+
+// recordRevision stores the revision produced by an edit. The first edit
+// also stores the original version as revision 1.
+func (r *Repository) recordRevision(editsBefore int, original, revision *models.Revision) {
+	if editsBefore == 0 {
+		original.ID = primitive.NewObjectID()
+		original.Revision = 1
+		r.revisions = append(r.revisions, clone(original))
+	}
+
+	revision.ID = primitive.NewObjectID()
+	revision.Revision = editsBefore + 2
+	r.revisions = append(r.revisions, clone(revision))
+}
+
+func (r *Repository) GetRevisions(ctx context.Context, targetType, targetID string) ([]models.Revision, error) {
+	id, err := mongodb.ParseID("target_id", targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	revisions := []models.Revision{}
+	for _, revision := range r.revisions {
+		if revision.TargetType == targetType && revision.TargetID == id {
+			revisions = append(revisions, *clone(revision))
+		}
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		if revisions[i].Revision != revisions[j].Revision {
+			return revisions[i].Revision < revisions[j].Revision
+		}
+		return bytes.Compare(revisions[i].ID[:], revisions[j].ID[:]) < 0
+	})
+	return revisions, nil
+}
@@ -0,0 +1,136 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// Text weights match the MongoDB text indexes and search ranking.
+const (
+	questionTitleWeight   = 10
+	questionDetailsWeight = 4
+	answerScoreWeight     = 2
+)
+
+// searchTerms splits a keyword into lowercase terms, dropping the phrase
+// quotes and negation that MongoDB's $text would treat as operators.
+func searchTerms(keyword string) []string {
+	return strings.FieldsFunc(strings.ToLower(keyword), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsNumber(c)
+	})
+}
+
+// termScore counts the words of text that are one of terms. Unlike MongoDB it
+// does not stem words, so only exact, case-insensitive matches count.
+func termScore(text string, terms []string) float64 {
+	if len(terms) == 0 {
+		return 0
+	}
+
+	var score float64
+	for _, word := range searchTerms(text) {
+		for _, term := range terms {
+			if word == term {
+				score++
+				break
+			}
+		}
+	}
+	return score
+}
+
+// SearchQuestionsAnswersUsers scores by word counts rather than MongoDB text
+// scores, so rankings only agree with MongoDB for clear-cut cases.
+func (r *Repository) SearchQuestionsAnswersUsers(ctx context.Context, keyword string, limit, offset int) (*models.SearchResult, error) {
+	terms := searchTerms(keyword)
+	if len(terms) == 0 {
+		return &models.SearchResult{}, nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	type scored struct {
+		questionID    primitive.ObjectID
+		questionScore float64
+		answerScore   float64
+		answer        *models.Answer
+	}
+	byQuestion := make(map[primitive.ObjectID]*scored)
+
+	for _, q := range r.questions {
+		if q.DeletedAt != nil || q.IsHidden {
+			continue
+		}
+		score := questionTitleWeight*termScore(q.Question, terms) + questionDetailsWeight*termScore(q.Details, terms)
+		if score > 0 {
+			byQuestion[q.ID] = &scored{questionID: q.ID, questionScore: score}
+		}
+	}
+
+	for _, a := range r.answers {
+		if a.DeletedAt != nil || a.IsHidden {
+			continue
+		}
+		// Answers of deleted or hidden questions must not count towards
+		// the ranking or the total
+		if q, ok := r.liveQuestion(a.QuestionID); !ok || q.IsHidden {
+			continue
+		}
+		score := termScore(a.Answer, terms)
+		if score == 0 {
+			continue
+		}
+
+		entry, ok := byQuestion[a.QuestionID]
+		if !ok {
+			entry = &scored{questionID: a.QuestionID}
+			byQuestion[a.QuestionID] = entry
+		}
+		if entry.answer == nil || score > entry.answerScore {
+			answer := clone(a)
+			answer.Vote, answer.Flags = nil, nil
+			entry.answer, entry.answerScore = answer, score
+		}
+	}
+
+	ranked := make([]*scored, 0, len(byQuestion))
+	for _, entry := range byQuestion {
+		ranked = append(ranked, entry)
+	}
+	score := func(entry *scored) float64 {
+		return entry.questionScore + answerScoreWeight*entry.answerScore
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if si, sj := score(ranked[i]), score(ranked[j]); si != sj {
+			return si > sj
+		}
+		return ranked[i].questionID.Hex() > ranked[j].questionID.Hex()
+	})
+
+	result := &models.SearchResult{Total: int64(len(ranked))}
+	if offset >= len(ranked) {
+		return result, nil
+	}
+	ranked = ranked[offset:]
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+		result.Next = offset + limit
+	}
+
+	for _, entry := range ranked {
+		result.Hits = append(result.Hits, models.SearchHit{
+			Question: *clone(r.questions[entry.questionID]),
+			Answer:   entry.answer,
+			Score:    score(entry),
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,323 @@
+package mongodb
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// Migration is a one-off data migration that can be run with cmd/migrate.
+// Every migration must be safe to run more than once.
+type Migration struct {
+	Name        string
+	Description string
+	Run         func(r *MongoRepository, ctx context.Context) (int64, error)
+}
+
+// Migrations are listed in the order they should be applied.
+var Migrations = []Migration{
+	{
+		Name:        "split-answers",
+		Description: "copy answers embedded in questions into the answers collection",
+		Run:         (*MongoRepository).MigrateSplitAnswers,
+	},
+	{
+		Name:        "split-answers-cleanup",
+		Description: "remove embedded answers once every instance reads the answers collection",
+		Run:         (*MongoRepository).MigrateSplitAnswersCleanup,
+	},
+	{
+		Name:        "answer-votes",
+		Description: "merge legacy voted_by entries into the votes ledger and recount upvotes/downvotes",
+		Run:         (*MongoRepository).MigrateAnswerVotes,
+	},
+	{
+		Name:        "flag-reasons",
+		Description: "move free-text flag reasons into details and file them under the other reason",
+		Run:         (*MongoRepository).MigrateFlagReasons,
+	},
+	{
+		Name:        "derive-is-answered",
+		Description: "set is_answered from whether a question has an accepted answer",
+		Run:         (*MongoRepository).MigrateDeriveIsAnswered,
+	},
+}
+
+// FindMigration returns the migration with the given name.
+func FindMigration(name string) (Migration, bool) {
+	for _, m := range Migrations {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return Migration{}, false
+}
+
+// MigrateSplitAnswers copies the answers embedded in question documents into
+// the answers collection and sets answer_count. It leaves the embedded copies
+// in place, so instances still running the old code keep working, and it can
+// be re-run at any time: answers are upserted by _id with $setOnInsert, so an
+// answer that already lives in the answers collection (and may have been
+// voted on there since) is never overwritten.
+//
+// Roll out by running split-answers, deploying, and then running
+// split-answers-cleanup, which copies anything written in between before
+// removing the embedded answers.
+func (r *MongoRepository) MigrateSplitAnswers(ctx context.Context) (int64, error) {
+	return r.splitAnswers(ctx, false)
+}
+
+// MigrateSplitAnswersCleanup copies any answers still embedded in questions
+// and then pulls them out of the question documents.
+func (r *MongoRepository) MigrateSplitAnswersCleanup(ctx context.Context) (int64, error) {
+	return r.splitAnswers(ctx, true)
+}
+
+func (r *MongoRepository) splitAnswers(ctx context.Context, removeEmbedded bool) (int64, error) {
+	var document struct {
+		ID      primitive.ObjectID `bson:"_id"`
+		Answers []bson.M           `bson:"answers"`
+	}
+
+	opts := options.Find().SetProjection(bson.M{"answers": 1})
+	cursor, err := r.questions.Find(ctx, bson.M{"answers": bson.M{"$exists": true}}, opts)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var migrated int64
+	for cursor.Next(ctx) {
+		document.Answers = nil
+		if err := cursor.Decode(&document); err != nil {
+			return migrated, err
+		}
+
+		ids := make([]interface{}, 0, len(document.Answers))
+		writes := make([]mongo.WriteModel, 0, len(document.Answers))
+		for _, answer := range document.Answers {
+			answer["question_id"] = document.ID
+			ids = append(ids, answer["_id"])
+			writes = append(writes, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"_id": answer["_id"]}).
+				SetUpdate(bson.M{"$setOnInsert": answer}).
+				SetUpsert(true))
+		}
+
+		if len(writes) > 0 {
+			result, err := r.answers.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+			if err != nil {
+				return migrated, err
+			}
+			migrated += result.UpsertedCount
+		}
+
+		count, err := r.answers.CountDocuments(ctx, bson.M{"question_id": document.ID})
+		if err != nil {
+			return migrated, err
+		}
+
+		update := bson.M{"$set": bson.M{"answer_count": count}}
+		if removeEmbedded {
+			// Pull only the answers copied above; anything appended since is
+			// left for the next run.
+			update["$pull"] = bson.M{"answers": bson.M{"_id": bson.M{"$in": ids}}}
+		}
+
+		if _, err := r.questions.UpdateOne(ctx, bson.M{"_id": document.ID}, update); err != nil {
+			return migrated, err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return migrated, err
+	}
+
+	if removeEmbedded {
+		_, err = r.questions.UpdateMany(ctx, bson.M{"answers": bson.M{"$size": 0}}, bson.M{
+			"$unset": bson.M{"answers": ""},
+		})
+		if err != nil {
+			return migrated, err
+		}
+	}
+
+	return migrated, nil
+}
+
+// MigrateAnswerVotes rebuilds the votes ledger and vote counters of answers
+// that still have legacy vote data: a voted_by list, no votes ledger, or
+// counters that disagree with the ledger. Votes that older versions pushed
+// into voted_by are merged into votes, keeping the latest vote per user, and
+// upvotes/downvotes are recounted from the result. It returns the number of
+// answers that were rewritten.
+func (r *MongoRepository) MigrateAnswerVotes(ctx context.Context) (int64, error) {
+	var answer struct {
+		ID        primitive.ObjectID `bson:"_id"`
+		Upvotes   int                `bson:"upvotes"`
+		Downvotes int                `bson:"downvotes"`
+		Votes     []models.Vote      `bson:"votes"`
+		VotedBy   []models.Vote      `bson:"voted_by"`
+	}
+
+	opts := options.Find().SetProjection(bson.M{
+		"upvotes":   1,
+		"downvotes": 1,
+		"votes":     1,
+		"voted_by":  1,
+	})
+
+	cursor, err := r.answers.Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"voted_by": bson.M{"$exists": true}},
+		bson.M{"votes": bson.M{"$not": bson.M{"$type": "array"}}},
+		bson.M{"$expr": bson.M{"$or": bson.A{
+			bson.M{"$ne": bson.A{"$upvotes", countVotesExpr(models.VoteTypeUpvote)}},
+			bson.M{"$ne": bson.A{"$downvotes", countVotesExpr(models.VoteTypeDownvote)}},
+		}}},
+	}}, opts)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var migrated int64
+	for cursor.Next(ctx) {
+		answer.Votes, answer.VotedBy = nil, nil
+		if err := cursor.Decode(&answer); err != nil {
+			return migrated, err
+		}
+
+		votes := mergeVotes(answer.Votes, answer.VotedBy)
+		upvotes, downvotes := countVotes(votes)
+
+		// Only rewrite the answer if its counters have not moved since we
+		// read it, so a vote cast during the migration is never lost.
+		filter := bson.M{
+			"_id":       answer.ID,
+			"upvotes":   answer.Upvotes,
+			"downvotes": answer.Downvotes,
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"votes":     votes,
+				"upvotes":   upvotes,
+				"downvotes": downvotes,
+			},
+			"$unset": bson.M{"voted_by": ""},
+		}
+
+		result, err := r.answers.UpdateOne(ctx, filter, update)
+		if err != nil {
+			return migrated, err
+		}
+		if result.MatchedCount == 0 {
+			log.Printf("answer %s changed during migration, skipping; re-run to retry", answer.ID.Hex())
+			continue
+		}
+		migrated++
+	}
+
+	return migrated, cursor.Err()
+}
+
+// MigrateFlagReasons rewrites flags whose reason is not one of
+// models.FlagReasons, which older versions accepted as free text. The text
+// is kept in details, unless the flag already has some, and the reason
+// becomes other. It returns the number of posts that were rewritten.
+func (r *MongoRepository) MigrateFlagReasons(ctx context.Context) (int64, error) {
+	legacy := bson.M{"$not": bson.M{"$in": bson.A{"$$flag.reason", models.FlagReasons}}}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"flags": bson.M{"$map": bson.M{
+			"input": "$flags",
+			"as":    "flag",
+			"in": bson.M{"$cond": bson.A{
+				legacy,
+				bson.M{"$mergeObjects": bson.A{"$$flag", bson.M{
+					"reason":  models.FlagReasonOther,
+					"details": bson.M{"$ifNull": bson.A{"$$flag.details", "$$flag.reason"}},
+				}}},
+				"$$flag",
+			}},
+		}}}}},
+	}
+	filter := bson.M{"flags": bson.M{"$elemMatch": bson.M{"reason": bson.M{"$nin": models.FlagReasons}}}}
+
+	var migrated int64
+	for _, collection := range []*mongo.Collection{r.questions, r.answers, r.comments} {
+		result, err := collection.UpdateMany(ctx, filter, update)
+		if err != nil {
+			return migrated, err
+		}
+		migrated += result.ModifiedCount
+	}
+
+	return migrated, nil
+}
+
+// MigrateDeriveIsAnswered makes is_answered agree with accepted_answer_id.
+// MarkQuestionAsAnswered used to set it on questions without an accepted
+// answer.
+func (r *MongoRepository) MigrateDeriveIsAnswered(ctx context.Context) (int64, error) {
+	var migrated int64
+	for _, answered := range []bool{true, false} {
+		result, err := r.questions.UpdateMany(ctx,
+			bson.M{"accepted_answer_id": bson.M{"$exists": answered}, "is_answered": !answered},
+			bson.M{"$set": bson.M{"is_answered": answered}},
+		)
+		if err != nil {
+			return migrated, err
+		}
+		migrated += result.ModifiedCount
+	}
+
+	return migrated, nil
+}
+
+// mergeVotes combines vote lists, keeping only the most recent vote per user.
+func mergeVotes(lists ...[]models.Vote) []models.Vote {
+	latest := make(map[string]models.Vote)
+	for _, list := range lists {
+		for _, vote := range list {
+			if existing, ok := latest[vote.UserID]; !ok || vote.VotedAt.After(existing.VotedAt) {
+				latest[vote.UserID] = vote
+			}
+		}
+	}
+
+	votes := make([]models.Vote, 0, len(latest))
+	for _, vote := range latest {
+		votes = append(votes, vote)
+	}
+	sort.Slice(votes, func(i, j int) bool {
+		return votes[i].VotedAt.Before(votes[j].VotedAt)
+	})
+	return votes
+}
+
+// countVotesExpr is an aggregation expression counting the votes of voteType
+// in an answer's votes ledger.
+func countVotesExpr(voteType string) bson.M {
+	return bson.M{"$size": bson.M{"$filter": bson.M{
+		"input": bson.M{"$ifNull": bson.A{"$votes", bson.A{}}},
+		"as":    "vote",
+		"cond":  bson.M{"$eq": bson.A{"$$vote.vote_type", voteType}},
+	}}}
+}
+
+func countVotes(votes []models.Vote) (upvotes, downvotes int) {
+	for _, vote := range votes {
+		switch vote.VoteType {
+		case models.VoteTypeUpvote:
+			upvotes++
+		case models.VoteTypeDownvote:
+			downvotes++
+		}
+	}
+	return upvotes, downvotes
+}
@@ -0,0 +1,372 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// moderationTargets lists the kinds of post that can be flagged, in the
+// order the moderation queue merges them.
+var moderationTargets = []string{models.TargetQuestion, models.TargetAnswer, models.TargetComment}
+
+// moderationCollection returns the collection holding posts of targetType.
+func (r *MongoRepository) moderationCollection(targetType string) (*mongo.Collection, error) {
+	switch targetType {
+	case models.TargetQuestion:
+		return r.questions, nil
+	case models.TargetAnswer:
+		return r.answers, nil
+	case models.TargetComment:
+		return r.comments, nil
+	default:
+		return nil, errs.InvalidField("target_type", "must be question, answer or comment")
+	}
+}
+
+// moderationStages turns the posts of targetType matching match into
+// ModerationItems that carry only their open flags.
+func moderationStages(targetType string, match bson.M) mongo.Pipeline {
+	body, questionID := "$body", "$question_id"
+	switch targetType {
+	case models.TargetQuestion:
+		body, questionID = "$question", "$_id"
+	case models.TargetAnswer:
+		body = "$answer"
+	}
+
+	return mongo.Pipeline{
+		{{Key: "$match", Value: withFilter(match, bson.M{"deleted_at": nil})}},
+		{{Key: "$project", Value: bson.M{
+			"target_type": bson.M{"$literal": targetType},
+			"question_id": questionID,
+			"user_id":     1,
+			"body":        body,
+			"is_hidden":   bson.M{"$ifNull": bson.A{"$is_hidden", false}},
+			"auto_hidden": bson.M{"$ifNull": bson.A{"$auto_hidden", false}},
+			"flags": bson.M{"$filter": bson.M{
+				"input": bson.M{"$ifNull": bson.A{"$flags", bson.A{}}},
+				"as":    "flag",
+				"cond":  bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$$flag.resolved_at", nil}}, nil}},
+			}},
+		}}},
+		{{Key: "$addFields", Value: bson.M{"flagged_at": bson.M{"$max": "$flags.created_at"}}}},
+	}
+}
+
+func (r *MongoRepository) ListModerationQueue(ctx context.Context, targetType, reason string, page models.Page) (*models.ModerationPage, error) {
+	targets := moderationTargets
+	if targetType != "" {
+		targets = []string{targetType}
+	}
+
+	var pipeline mongo.Pipeline
+	var base *mongo.Collection
+	for _, target := range targets {
+		collection, err := r.moderationCollection(target)
+		if err != nil {
+			return nil, err
+		}
+
+		stages := moderationStages(target, bson.M{"is_flagged": true})
+		if base == nil {
+			base, pipeline = collection, stages
+			continue
+		}
+		pipeline = append(pipeline, bson.D{{Key: "$unionWith", Value: bson.M{
+			"coll":     collection.Name(),
+			"pipeline": stages,
+		}}})
+	}
+
+	match := bson.M{"flags.0": bson.M{"$exists": true}}
+	if reason != "" {
+		match["flags.reason"] = reason
+	}
+
+	pipeline = append(pipeline,
+		bson.D{{Key: "$match", Value: pageFilterBy("flagged_at", match, page)}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "flagged_at", Value: -1}, {Key: "_id", Value: -1}}}},
+		bson.D{{Key: "$limit", Value: page.Limit + 1}},
+	)
+
+	cursor, err := base.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var items []models.ModerationItem
+	if err = cursor.All(ctx, &items); err != nil {
+		return nil, dbError(err)
+	}
+
+	return moderationPage(items, page), nil
+}
+
+func (r *MongoRepository) CountFlagsByReason(ctx context.Context, query models.FlagCountQuery) (map[string]int64, error) {
+	targets := moderationTargets
+	if query.TargetType != "" {
+		targets = []string{query.TargetType}
+	}
+
+	flagMatch := bson.M{}
+	if !query.Since.IsZero() {
+		flagMatch["created_at"] = bson.M{"$gte": query.Since}
+	}
+	if !query.IncludeResolved {
+		flagMatch["resolved_at"] = nil
+	}
+
+	stages := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"flags": bson.M{"$elemMatch": flagMatch}}}},
+		{{Key: "$unwind", Value: "$flags"}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$flags"}}},
+		{{Key: "$match", Value: flagMatch}},
+		{{Key: "$project", Value: bson.M{"reason": 1}}},
+	}
+
+	var pipeline mongo.Pipeline
+	var base *mongo.Collection
+	for _, target := range targets {
+		collection, err := r.moderationCollection(target)
+		if err != nil {
+			return nil, err
+		}
+
+		if base == nil {
+			base, pipeline = collection, append(mongo.Pipeline{}, stages...)
+			continue
+		}
+		pipeline = append(pipeline, bson.D{{Key: "$unionWith", Value: bson.M{
+			"coll":     collection.Name(),
+			"pipeline": stages,
+		}}})
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$group", Value: bson.M{
+		"_id":   "$reason",
+		"count": bson.M{"$sum": 1},
+	}}})
+
+	cursor, err := base.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		Reason string `bson:"_id"`
+		Count  int64  `bson:"count"`
+	}
+	if err = cursor.All(ctx, &groups); err != nil {
+		return nil, dbError(err)
+	}
+
+	counts := make(map[string]int64, len(groups))
+	for _, group := range groups {
+		counts[group.Reason] += group.Count
+	}
+	return counts, nil
+}
+
+func (r *MongoRepository) GetModerationItem(ctx context.Context, targetType, targetID string) (*models.ModerationItem, error) {
+	collection, err := r.moderationCollection(targetType)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := ParseID("target_id", targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := collection.Aggregate(ctx, moderationStages(targetType, bson.M{"_id": id}))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var items []models.ModerationItem
+	if err = cursor.All(ctx, &items); err != nil {
+		return nil, dbError(err)
+	}
+
+	if len(items) == 0 {
+		return nil, errs.NotFoundf("%s not found", targetType)
+	}
+	return &items[0], nil
+}
+
+// addFlag adds flag to the post matching filter unless its user already has
+// an open flag there, and hides the post if that takes it over hide's
+// limits. The whole change is a single update, so concurrent flags are
+// counted exactly once.
+func addFlag(ctx context.Context, collection *mongo.Collection, targetType string, filter bson.M, flag models.Flag, hide models.AutoHide) error {
+	flag.CreatedAt = time.Now()
+
+	open := bson.M{"$filter": bson.M{
+		"input": "$flags",
+		"as":    "flag",
+		"cond":  bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$$flag.resolved_at", nil}}, nil}},
+	}}
+	var limits bson.A
+	if hide.Flags > 0 {
+		limits = append(limits, bson.M{"$gte": bson.A{bson.M{"$size": bson.M{"$setUnion": bson.A{"$$open.user_id"}}}, hide.Flags}})
+	}
+	if hide.Score > 0 {
+		weights := bson.M{"$map": bson.M{"input": "$$open", "in": bson.M{"$ifNull": bson.A{"$$this.weight", 1}}}}
+		limits = append(limits, bson.M{"$gte": bson.A{bson.M{"$sum": weights}, hide.Score}})
+	}
+	overLimit := bson.M{"$let": bson.M{
+		"vars": bson.M{"open": open},
+		"in":   bson.M{"$or": append(limits, false)},
+	}}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"flags":      bson.M{"$concatArrays": bson.A{bson.M{"$ifNull": bson.A{"$flags", bson.A{}}}, bson.A{bson.M{"$literal": flag}}}},
+			"is_flagged": true,
+		}}},
+		// Posts a moderator already hid stay hidden without becoming
+		// auto-hidden, so that dismissing later flags does not reveal them.
+		{{Key: "$set", Value: bson.M{
+			"auto_hidden": bson.M{"$or": bson.A{
+				bson.M{"$ifNull": bson.A{"$auto_hidden", false}},
+				bson.M{"$and": bson.A{bson.M{"$ne": bson.A{"$is_hidden", true}}, overLimit}},
+			}},
+			"is_hidden": bson.M{"$or": bson.A{bson.M{"$ifNull": bson.A{"$is_hidden", false}}, overLimit}},
+		}}},
+	}
+
+	result, err := collection.UpdateOne(ctx, withFilter(filter, bson.M{
+		"flags": bson.M{"$not": bson.M{"$elemMatch": bson.M{"user_id": flag.UserID, "resolved_at": nil}}},
+	}), update)
+	if err != nil {
+		return dbError(err)
+	}
+
+	if result.MatchedCount == 0 {
+		count, err := collection.CountDocuments(ctx, filter)
+		if err != nil {
+			return dbError(err)
+		}
+		if count > 0 {
+			return errs.AlreadyExistsf("you have already flagged this %s", targetType)
+		}
+		return errs.NotFoundf("%s not found", targetType)
+	}
+
+	return nil
+}
+
+func (r *MongoRepository) ResolveFlags(ctx context.Context, targetType, targetID string, resolution models.FlagResolution) error {
+	collection, err := r.moderationCollection(targetType)
+	if err != nil {
+		return err
+	}
+
+	id, err := ParseID("target_id", targetID)
+	if err != nil {
+		return err
+	}
+
+	set := bson.M{
+		"flags.$[open].resolution":      resolution.Resolution,
+		"flags.$[open].resolved_by":     resolution.ModeratorID,
+		"flags.$[open].resolved_at":     time.Now(),
+		"flags.$[open].resolution_note": resolution.Note,
+		"is_flagged":                    false,
+	}
+	update := bson.M{"$set": set}
+	if resolution.Resolution == models.FlagHidden {
+		set["is_hidden"] = true
+		update["$unset"] = bson.M{"auto_hidden": ""}
+	}
+
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{"open.resolved_at": nil}},
+	})
+	result, err := collection.UpdateOne(ctx, bson.M{
+		"_id":        id,
+		"deleted_at": nil,
+		"flags":      bson.M{"$elemMatch": bson.M{"resolved_at": nil}},
+	}, update, opts)
+	if err != nil {
+		return dbError(err)
+	}
+
+	if result.MatchedCount == 0 {
+		return errs.NotFoundf("%s has no open flags", targetType)
+	}
+
+	if resolution.Resolution != models.FlagHidden {
+		_, err = collection.UpdateOne(ctx, bson.M{"_id": id, "auto_hidden": true}, bson.M{
+			"$set":   bson.M{"is_hidden": false},
+			"$unset": bson.M{"auto_hidden": ""},
+		})
+		if err != nil {
+			return dbError(err)
+		}
+	}
+	return nil
+}
+
+func (r *MongoRepository) ReopenFlags(ctx context.Context, targetType, targetID string, resolution models.FlagResolution) error {
+	collection, err := r.moderationCollection(targetType)
+	if err != nil {
+		return err
+	}
+
+	id, err := ParseID("target_id", targetID)
+	if err != nil {
+		return err
+	}
+
+	resolved := bson.M{
+		"resolution":      resolution.Resolution,
+		"resolved_by":     resolution.ModeratorID,
+		"resolution_note": resolution.Note,
+	}
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{
+			"done.resolution":      resolution.Resolution,
+			"done.resolved_by":     resolution.ModeratorID,
+			"done.resolution_note": resolution.Note,
+		}},
+	})
+	result, err := collection.UpdateOne(ctx, bson.M{
+		"_id":   id,
+		"flags": bson.M{"$elemMatch": resolved},
+	}, bson.M{
+		"$unset": bson.M{
+			"flags.$[done].resolution":      "",
+			"flags.$[done].resolved_by":     "",
+			"flags.$[done].resolved_at":     "",
+			"flags.$[done].resolution_note": "",
+		},
+		"$set": bson.M{"is_flagged": true},
+	}, opts)
+	if err != nil {
+		return dbError(err)
+	}
+
+	if result.MatchedCount == 0 {
+		return errs.NotFoundf("%s has no flags resolved that way", targetType)
+	}
+	return nil
+}
+
+func (r *MongoRepository) AddWarning(ctx context.Context, warning *models.Warning) error {
+	warning.ID = primitive.NewObjectID()
+	warning.CreatedAt = time.Now()
+
+	_, err := r.warnings.InsertOne(ctx, warning)
+	return dbError(err)
+}
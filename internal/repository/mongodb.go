@@ -9,61 +9,206 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
 
+	"github.com/liju-github/ContentService/internal/errs"
 	"github.com/liju-github/ContentService/internal/models"
 )
 
 type Repository interface {
 	PostQuestion(ctx context.Context, question *models.Question) error
-	GetQuestionsByUserID(ctx context.Context, userID string) ([]models.Question, error)
-	GetQuestionsByTags(ctx context.Context, tags []string) ([]models.Question, error)
-	GetQuestionsByWord(ctx context.Context, word string) ([]models.Question, error)
-	DeleteQuestion(ctx context.Context, questionID string) error
+	GetQuestionsByUserID(ctx context.Context, userID string, page models.Page) (*models.QuestionPage, error)
+	GetQuestionsByTags(ctx context.Context, tags []string, page models.Page) (*models.QuestionPage, error)
+	GetQuestionsByWord(ctx context.Context, word string, page models.Page) (*models.QuestionPage, error)
+	// DeleteQuestion turns the question into a tombstone recording who deleted
+	// it and why. Its live answers are deleted with it and come back when the
+	// question is restored.
+	DeleteQuestion(ctx context.Context, questionID, deletedBy, reason string) error
+	// RestoreQuestion undoes DeleteQuestion, including the answers that were
+	// deleted along with the question.
+	RestoreQuestion(ctx context.Context, questionID string) (*models.Question, error)
+	// GetDeletedQuestion returns a question that has been deleted but not purged.
+	GetDeletedQuestion(ctx context.Context, questionID string) (*models.Question, error)
+	// PurgeDeleted hard-deletes questions and answers that were deleted before
+	// the given time, together with their revisions and comments. It returns the
+	// number of questions and answers removed.
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
 	GetQuestionByID(ctx context.Context, questionID string) (*models.Question, error)
+	// PostAnswer stores the answer and bumps the question's answer_count.
 	PostAnswer(ctx context.Context, questionID string, answer *models.Answer) error
-	DeleteAnswer(ctx context.Context, questionID, answerID string) error
-	FlagQuestion(ctx context.Context, questionID, userID, reason string) error
-	FlagAnswer(ctx context.Context, questionID, answerID, userID, reason string) error
+	// DeleteAnswer turns the answer into a tombstone recording who deleted it
+	// and why. Its comments and revisions are kept so that it can be restored. A
+	// deleted answer can no longer be the accepted one.
+	DeleteAnswer(ctx context.Context, questionID, answerID, deletedBy, reason string) error
+	// RestoreAnswer undoes DeleteAnswer. An answer that was accepted before it
+	// was deleted has to be accepted again. Answers deleted along with their
+	// question are restored by RestoreQuestion instead.
+	RestoreAnswer(ctx context.Context, questionID, answerID string) (*models.Answer, error)
+	// GetDeletedAnswer returns an answer that has been deleted but not purged.
+	GetDeletedAnswer(ctx context.Context, questionID, answerID string) (*models.Answer, error)
+	FlagQuestion(ctx context.Context, questionID string, flag models.Flag, hide models.AutoHide) error
+	FlagAnswer(ctx context.Context, questionID, answerID string, flag models.Flag, hide models.AutoHide) error
+	// MarkQuestionAsAnswered confirms that a question is answered. A
+	// question is answered exactly when it has an accepted answer, so this
+	// fails with FailedPrecondition when it has none.
 	MarkQuestionAsAnswered(ctx context.Context, questionID string) error
-	GetUserFeed(ctx context.Context, userID string) ([]models.Question, error)
-	GetFlaggedQuestions(ctx context.Context) ([]models.Question, int32, error)
-	GetFlaggedAnswers(ctx context.Context) ([]models.Answer, int32, error)
+	// AcceptAnswer records answerID as the question's accepted answer, which
+	// also marks the question as answered.
+	AcceptAnswer(ctx context.Context, questionID, answerID string) error
+	UnacceptAnswer(ctx context.Context, questionID string) error
+	// GetUserFeed ranks questions for a user by the tags and authors they
+	// follow, recency and whether the question is still unanswered. The user's
+	// own questions are left out.
+	GetUserFeed(ctx context.Context, query models.FeedQuery) (*models.FeedPage, error)
+	GetFlaggedQuestions(ctx context.Context, page models.Page) (*models.QuestionPage, error)
+	GetFlaggedAnswers(ctx context.Context, page models.Page) (*models.AnswerPage, error)
 
 	//additional methods...
 	AddTag(ctx context.Context, tag *models.Tag) error
 	RemoveTag(ctx context.Context, tagName string) error
+	UpdateTag(ctx context.Context, tagName, description string) (*models.Tag, error)
+	GetTag(ctx context.Context, tagName string) (*models.Tag, error)
+	ListTags(ctx context.Context, page models.Page) (*models.TagPage, error)
+	GetTagsByNames(ctx context.Context, tagNames []string) ([]models.Tag, error)
+	// EnsureTags adds any of the given tags that are missing from the catalog.
+	EnsureTags(ctx context.Context, tagNames []string) error
 	UpvoteAnswer(ctx context.Context, questionID, answerID, userID string) error
-	DownvoteAnswer(ctx context.Context, questionID, answerID string) error
-	SearchQuestionsAnswersUsers(ctx context.Context, keyword string) (*models.SearchResult, error)
+	DownvoteAnswer(ctx context.Context, questionID, answerID, userID string) error
+	// ToggleVote casts the user's vote of voteType on an answer, switching a
+	// vote of the other type, or retracts the vote if they have already voted
+	// that way. It returns the answer with its counters after the change.
+	ToggleVote(ctx context.Context, questionID, answerID, userID, voteType string) (*models.Answer, error)
+	// SearchQuestionsAnswersUsers ranks questions by the text score of their
+	// title and details plus that of their best matching answer, and returns
+	// offset/limit of them at a time. Hidden and deleted posts, and answers of
+	// hidden or deleted questions, are left out of both the hits and the total.
+	// The keyword is searched as plain terms; search operators are ignored.
+	SearchQuestionsAnswersUsers(ctx context.Context, keyword string, limit, offset int) (*models.SearchResult, error)
 	// Additional methods for vote tracking
 	HasUserVotedOnAnswer(ctx context.Context, questionID, answerID, userID string) (bool, string, error)
 	GetAnswerOwnerID(ctx context.Context, questionID, answerID string) (string, error)
+	GetAnswerByID(ctx context.Context, questionID, answerID string) (*models.Answer, error)
+	GetAnswersByQuestionID(ctx context.Context, questionID string) ([]models.Answer, error)
 	GetUserIDFromQuestionID(ctx context.Context, questionID string) (string, error)
+
+	// Edits and revision history
+	// EditQuestion replaces the question's title, details and tags with those
+	// of the revision and records the revision. Concurrent edits always get
+	// distinct revision numbers.
+	EditQuestion(ctx context.Context, questionID string, revision *models.Revision) (*models.Question, error)
+	// EditAnswer replaces the answer text with that of the revision and
+	// records the revision.
+	EditAnswer(ctx context.Context, questionID, answerID string, revision *models.Revision) (*models.Answer, error)
+	// GetRevisions returns the stored revisions of a question or answer, oldest
+	// first. Posts that were never edited have no stored revisions.
+	GetRevisions(ctx context.Context, targetType, targetID string) ([]models.Revision, error)
+
+	// Comments
+	// PostComment stores a comment on the question or answer given by its
+	// TargetType, TargetID and QuestionID. Replies inherit the thread of their
+	// parent, which must belong to the same target.
+	PostComment(ctx context.Context, comment *models.Comment) error
+	GetCommentByID(ctx context.Context, commentID string) (*models.Comment, error)
+	EditComment(ctx context.Context, commentID, body string) (*models.Comment, error)
+	// DeleteComment turns a comment into a tombstone whose body is
+	// models.DeletedCommentBody. Replies to it are kept, and ListComments
+	// still returns it so that their thread holds together. Other reads and
+	// writes treat a deleted comment as missing.
+	DeleteComment(ctx context.Context, commentID string) error
+	// ListComments returns the comments on a question or answer, newest first.
+	// Replies are included; clients rebuild threads from parent IDs. Comments
+	// hidden by a moderator are left out. A deleted question or answer is
+	// reported as not found.
+	ListComments(ctx context.Context, targetType, targetID string, page models.Page) (*models.CommentPage, error)
+	FlagComment(ctx context.Context, commentID string, flag models.Flag, hide models.AutoHide) error
+
+	// Follows
+	Follow(ctx context.Context, follow *models.Follow) error
+	Unfollow(ctx context.Context, userID, targetType, target string) error
+	// ListFollows lists what a user follows, newest first. An empty targetType
+	// lists both tags and users.
+	ListFollows(ctx context.Context, userID, targetType string, page models.Page) (*models.FollowPage, error)
+
+	// Moderation
+	// ListModerationQueue returns the posts with open flags, most recently
+	// flagged first. An empty targetType lists questions, answers and comments
+	// together, and a non-empty reason keeps only posts with an open flag for
+	// that reason.
+	ListModerationQueue(ctx context.Context, targetType, reason string, page models.Page) (*models.ModerationPage, error)
+	// CountFlagsByReason returns how many flags were raised for each reason.
+	// Reasons without flags are left out.
+	CountFlagsByReason(ctx context.Context, query models.FlagCountQuery) (map[string]int64, error)
+	// GetModerationItem returns a post with its open flags, which may be none.
+	GetModerationItem(ctx context.Context, targetType, targetID string) (*models.ModerationItem, error)
+	// ResolveFlags records resolution on every open flag of a post and clears
+	// its is_flagged marker. A hidden resolution also hides the post for good,
+	// while any other resolution reveals a post that was only auto-hidden. Only
+	// one of several concurrent resolutions of the same flags succeeds; the
+	// others find no open flags.
+	ResolveFlags(ctx context.Context, targetType, targetID string, resolution models.FlagResolution) error
+	// ReopenFlags reopens the flags of a post that ResolveFlags closed with
+	// resolution, for when the action it stands for could not be applied. It
+	// marks the post flagged again, but a post that ResolveFlags revealed
+	// stays visible.
+	ReopenFlags(ctx context.Context, targetType, targetID string, resolution models.FlagResolution) error
+	// AddWarning records a moderator's warning to a user.
+	AddWarning(ctx context.Context, warning *models.Warning) error
+
+	// Audit log
+	// RecordAuditEvent appends an event to the audit log. Events are never
+	// updated or removed.
+	RecordAuditEvent(ctx context.Context, event *models.AuditEvent) error
+	// ListAuditEvents returns the audit events matching query, newest first.
+	ListAuditEvents(ctx context.Context, query models.AuditQuery, page models.Page) (*models.AuditPage, error)
+
+	// Ping reports an error when the backing database cannot be reached.
+	Ping(ctx context.Context) error
+	// Close releases the repository's connections. It is called once, on
+	// shutdown, after every other call has returned.
+	Close(ctx context.Context) error
 }
 
 type MongoRepository struct {
 	client    *mongo.Client
 	database  string
 	questions *mongo.Collection
+	answers   *mongo.Collection
 	tags      *mongo.Collection
+	revisions *mongo.Collection
+	comments  *mongo.Collection
+	follows   *mongo.Collection
+	warnings  *mongo.Collection
+	audit     *mongo.Collection
 }
 
 func NewMongoRepository(cfg *models.MongoConfig) (*MongoRepository, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	connectTimeout := cfg.ConnectTimeout
+	if connectTimeout <= 0 {
+		connectTimeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
 	defer cancel()
 
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.URI))
+	client, err := mongo.Connect(ctx, clientOptions(cfg, connectTimeout))
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 
 	// Ping database to verify connection
 	if err = client.Ping(ctx, nil); err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 
 	db := client.Database(cfg.Database)
 
+	// A collection can only have one text index, so drop the ones earlier
+	// versions created before adding the current one
+	for _, name := range []string{"question_text", "content_text"} {
+		if err = dropIndexIfExists(ctx, db.Collection("questions"), name); err != nil {
+			return nil, dbError(err)
+		}
+	}
+
 	// Create indexes
 	_, err = db.Collection("questions").Indexes().CreateMany(ctx, []mongo.IndexModel{
 		{
@@ -73,18 +218,161 @@ func NewMongoRepository(cfg *models.MongoConfig) (*MongoRepository, error) {
 			Keys: bson.D{{Key: "tags", Value: 1}},
 		},
 		{
-			Keys: bson.D{{Key: "question", Value: "text"}},
+			Keys: bson.D{{Key: "is_flagged", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "question", Value: "text"},
+				{Key: "details", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("question_details_text").
+				SetWeights(bson.D{
+					{Key: "question", Value: 10},
+					{Key: "details", Value: 4},
+				}),
 		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
+	}
+
+	_, err = db.Collection("answers").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "question_id", Value: 1}, {Key: "created_at", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "is_flagged", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "answer", Value: "text"}},
+		},
+		{
+			Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	_, err = db.Collection("tags").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	_, err = db.Collection("revisions").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "target_type", Value: 1},
+				{Key: "target_id", Value: 1},
+				{Key: "revision", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "question_id", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	_, err = db.Collection("comments").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "target_type", Value: 1},
+				{Key: "target_id", Value: 1},
+				{Key: "created_at", Value: -1},
+				{Key: "_id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{{Key: "ancestors", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "question_id", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "is_flagged", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	_, err = db.Collection("follows").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "target_type", Value: 1},
+				{Key: "target", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "created_at", Value: -1},
+				{Key: "_id", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	_, err = db.Collection("warnings").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	_, err = db.Collection("audit_events").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "actor_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+		},
+		{
+			Keys: bson.D{
+				{Key: "target_type", Value: 1},
+				{Key: "target_id", Value: 1},
+				{Key: "created_at", Value: -1},
+				{Key: "_id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{{Key: "action", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+		},
+	})
+	if err != nil {
+		return nil, dbError(err)
 	}
 
 	return &MongoRepository{
 		client:    client,
 		database:  cfg.Database,
 		questions: db.Collection("questions"),
+		answers:   db.Collection("answers"),
 		tags:      db.Collection("tags"),
+		revisions: db.Collection("revisions"),
+		comments:  db.Collection("comments"),
+		follows:   db.Collection("follows"),
+		warnings:  db.Collection("warnings"),
+		audit:     db.Collection("audit_events"),
 	}, nil
 }
 
@@ -92,602 +380,423 @@ func (r *MongoRepository) PostQuestion(ctx context.Context, question *models.Que
 	question.ID = primitive.NewObjectID()
 	question.CreatedAt = time.Now()
 	question.IsAnswered = false
+	question.AnswerCount = 0
 
 	_, err := r.questions.InsertOne(ctx, question)
-	return err
+	return dbError(err)
 }
 
-func (r *MongoRepository) GetQuestionsByUserID(ctx context.Context, userID string) ([]models.Question, error) {
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
-	cursor, err := r.questions.Find(ctx, bson.M{"user_id": userID}, opts)
+func (r *MongoRepository) GetQuestionsByUserID(ctx context.Context, userID string, page models.Page) (*models.QuestionPage, error) {
+	cursor, err := r.questions.Find(ctx, pageFilter(bson.M{"user_id": userID, "deleted_at": nil, "is_hidden": bson.M{"$ne": true}}, page), pageOptions(page))
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 	defer cursor.Close(ctx)
 
 	var questions []models.Question
 	if err = cursor.All(ctx, &questions); err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 
-	return questions, nil
+	return questionPage(questions, page), nil
 }
 
-func (r *MongoRepository) GetQuestionsByTags(ctx context.Context, tags []string) ([]models.Question, error) {
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
-	cursor, err := r.questions.Find(ctx, bson.M{"tags": bson.M{"$in": tags}}, opts)
+func (r *MongoRepository) GetQuestionsByTags(ctx context.Context, tags []string, page models.Page) (*models.QuestionPage, error) {
+	cursor, err := r.questions.Find(ctx, pageFilter(bson.M{"tags": bson.M{"$in": tags}, "deleted_at": nil, "is_hidden": bson.M{"$ne": true}}, page), pageOptions(page))
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 	defer cursor.Close(ctx)
 
 	var questions []models.Question
 	if err = cursor.All(ctx, &questions); err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 
-	return questions, nil
+	return questionPage(questions, page), nil
 }
 
-func (r *MongoRepository) GetQuestionsByWord(ctx context.Context, word string) ([]models.Question, error) {
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
-	cursor, err := r.questions.Find(ctx, bson.M{
-		"$text": bson.M{
-			"$search": word,
-		},
-	}, opts)
+func (r *MongoRepository) GetQuestionsByWord(ctx context.Context, word string, page models.Page) (*models.QuestionPage, error) {
+	cursor, err := r.questions.Find(ctx, pageFilter(bson.M{"$text": bson.M{"$search": word}, "deleted_at": nil, "is_hidden": bson.M{"$ne": true}}, page), pageOptions(page))
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 	defer cursor.Close(ctx)
 
 	var questions []models.Question
 	if err = cursor.All(ctx, &questions); err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 
-	return questions, nil
+	return questionPage(questions, page), nil
 }
 
-func (r *MongoRepository) DeleteQuestion(ctx context.Context, questionID string) error {
-	id, err := primitive.ObjectIDFromHex(questionID)
+func (r *MongoRepository) DeleteQuestion(ctx context.Context, questionID, deletedBy, reason string) error {
+	id, err := ParseID("question_id", questionID)
 	if err != nil {
 		return err
 	}
 
-	result, err := r.questions.DeleteOne(ctx, bson.M{"_id": id})
+	now := time.Now()
+	result, err := r.questions.UpdateOne(ctx, bson.M{"_id": id, "deleted_at": nil}, bson.M{"$set": bson.M{
+		"deleted_at":    now,
+		"deleted_by":    deletedBy,
+		"delete_reason": reason,
+	}})
 	if err != nil {
-		return err
+		return dbError(err)
 	}
 
-	if result.DeletedCount == 0 {
-		return errors.New("question not found")
+	if result.MatchedCount == 0 {
+		return errs.NotFoundf("question not found")
 	}
 
-	return nil
+	_, err = r.answers.UpdateMany(ctx, bson.M{"question_id": id, "deleted_at": nil}, bson.M{"$set": bson.M{
+		"deleted_at":            now,
+		"deleted_by":            deletedBy,
+		"deleted_with_question": true,
+	}})
+	return dbError(err)
 }
 
-func (r *MongoRepository) GetQuestionByID(ctx context.Context, questionID string) (*models.Question, error) {
-	id, err := primitive.ObjectIDFromHex(questionID)
+func (r *MongoRepository) GetDeletedQuestion(ctx context.Context, questionID string) (*models.Question, error) {
+	id, err := ParseID("question_id", questionID)
 	if err != nil {
 		return nil, err
 	}
 
 	var question models.Question
-	err = r.questions.FindOne(ctx, bson.M{"_id": id}).Decode(&question)
+	err = r.questions.FindOne(ctx, bson.M{"_id": id, "deleted_at": bson.M{"$ne": nil}}).Decode(&question)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, errors.New("question not found")
+			return nil, errs.NotFoundf("deleted question not found")
 		}
-		return nil, err
+		return nil, dbError(err)
 	}
 
 	return &question, nil
 }
 
-func (r *MongoRepository) GetAnswersByQuestionID(ctx context.Context, questionID string) ([]models.Answer, error) {
-	qID, err := primitive.ObjectIDFromHex(questionID)
+func (r *MongoRepository) RestoreQuestion(ctx context.Context, questionID string) (*models.Question, error) {
+	id, err := ParseID("question_id", questionID)
 	if err != nil {
 		return nil, err
 	}
 
-	var result struct {
-		Answers []models.Answer `bson:"answers"`
-	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
 
-	// Retrieve only the answers field from the question document
-	err = r.questions.FindOne(ctx, bson.M{"_id": qID}, options.FindOne().SetProjection(bson.M{"answers": 1})).Decode(&result)
+	var question models.Question
+	err = r.questions.FindOneAndUpdate(ctx, bson.M{"_id": id, "deleted_at": bson.M{"$ne": nil}}, bson.M{
+		"$unset": bson.M{"deleted_at": "", "deleted_by": "", "delete_reason": ""},
+	}, opts).Decode(&question)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, errors.New("question not found")
+			return nil, errs.NotFoundf("deleted question not found")
 		}
-		return nil, err
-	}
-
-	return result.Answers, nil
-}
-
-func (r *MongoRepository) PostAnswer(ctx context.Context, questionID string, answer *models.Answer) error {
-	qID, err := primitive.ObjectIDFromHex(questionID)
-	if err != nil {
-		return err
+		return nil, dbError(err)
 	}
 
-	answer.ID = primitive.NewObjectID()
-	answer.CreatedAt = time.Now()
-
-	update := bson.M{
-		"$push": bson.M{"answers": answer},
-	}
-
-	result, err := r.questions.UpdateOne(ctx, bson.M{"_id": qID}, update)
+	_, err = r.answers.UpdateMany(ctx, bson.M{"question_id": id, "deleted_with_question": true}, bson.M{
+		"$unset": bson.M{"deleted_at": "", "deleted_by": "", "deleted_with_question": ""},
+	})
 	if err != nil {
-		return err
+		return nil, dbError(err)
 	}
 
-	if result.MatchedCount == 0 {
-		return errors.New("question not found")
-	}
-
-	return nil
+	return &question, nil
 }
 
-func (r *MongoRepository) DeleteAnswer(ctx context.Context, questionID, answerID string) error {
-	qID, err := primitive.ObjectIDFromHex(questionID)
-	if err != nil {
-		return err
-	}
-
-	aID, err := primitive.ObjectIDFromHex(answerID)
+func (r *MongoRepository) GetQuestionByID(ctx context.Context, questionID string) (*models.Question, error) {
+	id, err := ParseID("question_id", questionID)
 	if err != nil {
-		return err
-	}
-
-	update := bson.M{
-		"$pull": bson.M{
-			"answers": bson.M{"_id": aID},
-		},
+		return nil, err
 	}
 
-	result, err := r.questions.UpdateOne(ctx, bson.M{"_id": qID}, update)
+	var question models.Question
+	err = r.questions.FindOne(ctx, bson.M{"_id": id, "deleted_at": nil}).Decode(&question)
 	if err != nil {
-		return err
-	}
-
-	if result.MatchedCount == 0 {
-		return errors.New("question not found")
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.NotFoundf("question not found")
+		}
+		return nil, dbError(err)
 	}
 
-	return nil
+	return &question, nil
 }
 
-func (r *MongoRepository) DownvoteAnswer(ctx context.Context, questionID, answerID string) error {
-	qID, err := primitive.ObjectIDFromHex(questionID)
-	if err != nil {
-		return err
-	}
-
-	aID, err := primitive.ObjectIDFromHex(answerID)
-	if err != nil {
-		return err
-	}
-
-	update := bson.M{
-		"$inc": bson.M{"answers.$[elem].downvotes": 1},
-	}
-
-	arrayFilters := options.Update().SetArrayFilters(options.ArrayFilters{
-		Filters: []interface{}{bson.M{"elem._id": aID}},
-	})
-
-	result, err := r.questions.UpdateOne(ctx, bson.M{"_id": qID}, update, arrayFilters)
+func (r *MongoRepository) FlagQuestion(ctx context.Context, questionID string, flag models.Flag, hide models.AutoHide) error {
+	qID, err := ParseID("question_id", questionID)
 	if err != nil {
 		return err
 	}
 
-	if result.MatchedCount == 0 {
-		return errors.New("question or answer not found")
-	}
-
-	return nil
+	return addFlag(ctx, r.questions, models.TargetQuestion, bson.M{"_id": qID, "deleted_at": nil}, flag, hide)
 }
 
-func (r *MongoRepository) FlagQuestion(ctx context.Context, questionID, userID, reason string) error {
-	qID, err := primitive.ObjectIDFromHex(questionID)
+func (r *MongoRepository) MarkQuestionAsAnswered(ctx context.Context, questionID string) error {
+	qID, err := ParseID("question_id", questionID)
 	if err != nil {
 		return err
 	}
 
-	flag := models.Flag{
-		UserID:    userID,
-		Reason:    reason,
-		CreatedAt: time.Now(),
-	}
-
-	update := bson.M{
-		"$push": bson.M{"flags": flag},
-		"$set":  bson.M{"is_flagged": true},
-	}
-
-	result, err := r.questions.UpdateOne(ctx, bson.M{"_id": qID}, update)
+	question, err := r.GetQuestionByID(ctx, qID.Hex())
 	if err != nil {
 		return err
 	}
-
-	if result.MatchedCount == 0 {
-		return errors.New("question not found")
+	if question.AcceptedAnswerID == nil {
+		return errs.FailedPreconditionf("question has no accepted answer; accept one with AcceptAnswer")
 	}
-
 	return nil
 }
 
-func (r *MongoRepository) FlagAnswer(ctx context.Context, questionID, answerID, userID, reason string) error {
-	qID, err := primitive.ObjectIDFromHex(questionID)
-	if err != nil {
-		return err
-	}
-
-	aID, err := primitive.ObjectIDFromHex(answerID)
+func (r *MongoRepository) AcceptAnswer(ctx context.Context, questionID, answerID string) error {
+	answer, err := r.GetAnswerByID(ctx, questionID, answerID)
 	if err != nil {
 		return err
 	}
 
-	flag := models.Flag{
-		UserID:    userID,
-		Reason:    reason,
-		CreatedAt: time.Now(),
-	}
-
 	update := bson.M{
-		"$push": bson.M{"answers.$[elem].flags": flag},
-		"$set":  bson.M{"answers.$[elem].is_flagged": true},
+		"$set": bson.M{
+			"accepted_answer_id": answer.ID,
+			"is_answered":        true,
+		},
 	}
 
-	arrayFilters := options.Update().SetArrayFilters(options.ArrayFilters{
-		Filters: []interface{}{bson.M{"elem._id": aID}},
-	})
-
-	result, err := r.questions.UpdateOne(ctx, bson.M{"_id": qID}, update, arrayFilters)
+	result, err := r.questions.UpdateOne(ctx, bson.M{"_id": answer.QuestionID, "deleted_at": nil}, update)
 	if err != nil {
-		return err
+		return dbError(err)
 	}
 
 	if result.MatchedCount == 0 {
-		return errors.New("question or answer not found")
+		return errs.NotFoundf("question not found")
 	}
 
 	return nil
 }
 
-func (r *MongoRepository) MarkQuestionAsAnswered(ctx context.Context, questionID string) error {
-	qID, err := primitive.ObjectIDFromHex(questionID)
+func (r *MongoRepository) UnacceptAnswer(ctx context.Context, questionID string) error {
+	qID, err := ParseID("question_id", questionID)
 	if err != nil {
 		return err
 	}
 
 	update := bson.M{
-		"$set": bson.M{"is_answered": true},
+		"$unset": bson.M{"accepted_answer_id": ""},
+		"$set":   bson.M{"is_answered": false},
 	}
 
-	result, err := r.questions.UpdateOne(ctx, bson.M{"_id": qID}, update)
+	result, err := r.questions.UpdateOne(ctx, bson.M{
+		"_id":                qID,
+		"accepted_answer_id": bson.M{"$exists": true},
+		"deleted_at":         nil,
+	}, update)
 	if err != nil {
-		return err
+		return dbError(err)
 	}
 
 	if result.MatchedCount == 0 {
-		return errors.New("question not found")
+		if _, err := r.GetUserIDFromQuestionID(ctx, questionID); err != nil {
+			return err
+		}
+		return errs.NotFoundf("question has no accepted answer")
 	}
 
 	return nil
 }
 
-func (r *MongoRepository) GetUserFeed(ctx context.Context, userID string) ([]models.Question, error) {
-	opts := options.Find().
-		SetSort(bson.D{{Key: "created_at", Value: -1}}).
-		SetLimit(50)
-
-	cursor, err := r.questions.Find(ctx, bson.M{}, opts)
-	if err != nil {
-		return nil, err
-	}
-	defer cursor.Close(ctx)
-
-	var questions []models.Question
-	if err = cursor.All(ctx, &questions); err != nil {
-		return nil, err
-	}
-
-	return questions, nil
-}
-
 func (r *MongoRepository) AddTag(ctx context.Context, tag *models.Tag) error {
 	tag.ID = primitive.NewObjectID()
+	tag.CreatedAt = time.Now()
+
 	_, err := r.tags.InsertOne(ctx, tag)
-	return err
+	if mongo.IsDuplicateKeyError(err) {
+		return errs.AlreadyExistsf("tag already exists")
+	}
+	return dbError(err)
 }
 
 func (r *MongoRepository) RemoveTag(ctx context.Context, tagName string) error {
 	result, err := r.tags.DeleteOne(ctx, bson.M{"name": tagName})
 	if err != nil {
-		return err
+		return dbError(err)
 	}
 
 	if result.DeletedCount == 0 {
-		return errors.New("tag not found")
+		return errs.NotFoundf("tag not found")
 	}
 
 	return nil
 }
 
-func (r *MongoRepository) SearchQuestionsAnswersUsers(ctx context.Context, keyword string) (*models.SearchResult, error) {
-	// Search in questions
-	questionsCursor, err := r.questions.Find(ctx, bson.M{
-		"$or": []bson.M{
-			{"question": bson.M{"$regex": keyword, "$options": "i"}},
-			{"answers.answer": bson.M{"$regex": keyword, "$options": "i"}},
-		},
-	})
-	if err != nil {
-		return nil, err
-	}
-	defer questionsCursor.Close(ctx)
-
-	var questions []models.Question
-	if err = questionsCursor.All(ctx, &questions); err != nil {
-		return nil, err
-	}
-
-	return &models.SearchResult{
-		Questions: questions,
-	}, nil
-}
-
-func (r *MongoRepository) Close(ctx context.Context) error {
-	return r.client.Disconnect(ctx)
-}
+func (r *MongoRepository) UpdateTag(ctx context.Context, tagName, description string) (*models.Tag, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
 
-func (r *MongoRepository) GetUserIDFromQuestionID(ctx context.Context, questionID string) (string, error) {
-	qID, err := primitive.ObjectIDFromHex(questionID)
+	var tag models.Tag
+	err := r.tags.FindOneAndUpdate(ctx, bson.M{"name": tagName}, bson.M{
+		"$set": bson.M{"description": description},
+	}, opts).Decode(&tag)
 	if err != nil {
-		return "", err
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.NotFoundf("tag not found")
+		}
+		return nil, dbError(err)
 	}
 
-	var result struct {
-		UserID string `bson:"user_id"`
-	}
+	return &tag, nil
+}
 
-	err = r.questions.FindOne(ctx, bson.M{"_id": qID}, options.FindOne().SetProjection(bson.M{"user_id": 1})).Decode(&result)
+func (r *MongoRepository) GetTag(ctx context.Context, tagName string) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.tags.FindOne(ctx, bson.M{"name": tagName}).Decode(&tag)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return "", errors.New("question not found")
+			return nil, errs.NotFoundf("tag not found")
 		}
-		return "", err
+		return nil, dbError(err)
 	}
 
-	return result.UserID, nil
+	return &tag, nil
 }
 
-func (r *MongoRepository) HasUserVotedOnAnswer(ctx context.Context, questionID, answerID, userID string) (bool, string, error) {
-	qID, err := primitive.ObjectIDFromHex(questionID)
+func (r *MongoRepository) ListTags(ctx context.Context, page models.Page) (*models.TagPage, error) {
+	cursor, err := r.tags.Find(ctx, pageFilter(bson.M{}, page), pageOptions(page))
 	if err != nil {
-		return false, "", err
+		return nil, dbError(err)
 	}
+	defer cursor.Close(ctx)
 
-	aID, err := primitive.ObjectIDFromHex(answerID)
-	if err != nil {
-		return false, "", err
+	var tags []models.Tag
+	if err = cursor.All(ctx, &tags); err != nil {
+		return nil, dbError(err)
 	}
 
-	// Find the question and the specific answer
-	var question models.Question
-	pipeline := mongo.Pipeline{
-		{{Key: "$match", Value: bson.M{"_id": qID}}},
-		{{Key: "$unwind", Value: "$answers"}},
-		{{Key: "$match", Value: bson.M{"answers._id": aID}}},
-	}
+	return tagPage(tags, page), nil
+}
 
-	cursor, err := r.questions.Aggregate(ctx, pipeline)
+func (r *MongoRepository) GetTagsByNames(ctx context.Context, tagNames []string) ([]models.Tag, error) {
+	cursor, err := r.tags.Find(ctx, bson.M{"name": bson.M{"$in": tagNames}})
 	if err != nil {
-		return false, "", err
+		return nil, dbError(err)
 	}
 	defer cursor.Close(ctx)
 
-	if !cursor.Next(ctx) {
-		return false, "", errors.New("answer not found")
-	}
-
-	if err := cursor.Decode(&question); err != nil {
-		return false, "", err
-	}
-
-	// Check if user has voted
-	for _, vote := range question.Answers[0].Vote {
-		if vote.UserID == userID {
-			return true, vote.VoteType, nil
-		}
+	var tags []models.Tag
+	if err = cursor.All(ctx, &tags); err != nil {
+		return nil, dbError(err)
 	}
 
-	return false, "", nil
+	return tags, nil
 }
 
-func (r *MongoRepository) GetAnswerOwnerID(ctx context.Context, questionID, answerID string) (string, error) {
-	qID, err := primitive.ObjectIDFromHex(questionID)
-	if err != nil {
-		return "", err
+func (r *MongoRepository) EnsureTags(ctx context.Context, tagNames []string) error {
+	if len(tagNames) == 0 {
+		return nil
 	}
 
-	aID, err := primitive.ObjectIDFromHex(answerID)
-	if err != nil {
-		return "", err
+	now := time.Now()
+	writes := make([]mongo.WriteModel, 0, len(tagNames))
+	for _, name := range tagNames {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"name": name}).
+			SetUpdate(bson.M{"$setOnInsert": bson.M{
+				"_id":         primitive.NewObjectID(),
+				"name":        name,
+				"description": "",
+				"created_at":  now,
+			}}).
+			SetUpsert(true))
 	}
 
-	// Find the question and the specific answer
-	var result struct {
-		Answers []struct {
-			ID     primitive.ObjectID `bson:"_id"`
-			UserID string             `bson:"user_id"`
-		} `bson:"answers"`
-	}
-
-	pipeline := mongo.Pipeline{
-		{{Key: "$match", Value: bson.M{"_id": qID}}},
-		{{Key: "$project", Value: bson.M{
-			"answers": bson.M{
-				"$filter": bson.M{
-					"input": "$answers",
-					"as":    "answer",
-					"cond":  bson.M{"$eq": []interface{}{"$$answer._id", aID}},
-				},
-			},
-		}}},
+	// A concurrent upsert of the same tag may lose the race on the unique
+	// index, which still leaves the tag in the catalog.
+	_, err := r.tags.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return dbError(err)
 	}
 
-	cursor, err := r.questions.Aggregate(ctx, pipeline)
-	if err != nil {
-		return "", err
-	}
-	defer cursor.Close(ctx)
+	return nil
+}
 
-	if !cursor.Next(ctx) {
-		return "", errors.New("question not found")
+// clientOptions applies the pool and timeout settings of cfg on top of the
+// ones in its URI.
+func clientOptions(cfg *models.MongoConfig, connectTimeout time.Duration) *options.ClientOptions {
+	opts := options.Client().ApplyURI(cfg.URI).SetConnectTimeout(connectTimeout)
+	if cfg.MaxPoolSize > 0 {
+		opts.SetMaxPoolSize(cfg.MaxPoolSize)
 	}
-
-	if err := cursor.Decode(&result); err != nil {
-		return "", err
+	if cfg.MinPoolSize > 0 {
+		opts.SetMinPoolSize(cfg.MinPoolSize)
 	}
-
-	if len(result.Answers) == 0 {
-		return "", errors.New("answer not found")
+	if cfg.MaxConnIdleTime > 0 {
+		opts.SetMaxConnIdleTime(cfg.MaxConnIdleTime)
 	}
-
-	return result.Answers[0].UserID, nil
+	if cfg.ServerSelectionTimeout > 0 {
+		opts.SetServerSelectionTimeout(cfg.ServerSelectionTimeout)
+	}
+	return opts
 }
 
-// Modify the UpvoteAnswer method to include vote tracking
-func (r *MongoRepository) UpvoteAnswer(ctx context.Context, questionID, answerID, userID string) error {
-	qID, err := primitive.ObjectIDFromHex(questionID)
-	if err != nil {
-		return err
+func dropIndexIfExists(ctx context.Context, collection *mongo.Collection, name string) error {
+	_, err := collection.Indexes().DropOne(ctx, name)
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && (cmdErr.Name == "IndexNotFound" || cmdErr.Name == "NamespaceNotFound") {
+		return nil
 	}
+	return dbError(err)
+}
 
-	aID, err := primitive.ObjectIDFromHex(answerID)
-	if err != nil {
-		return err
-	}
+func (r *MongoRepository) Ping(ctx context.Context) error {
+	return dbError(r.client.Ping(ctx, readpref.Primary()))
+}
 
-	// Check if user has already voted
-	hasVoted, voteType, err := r.HasUserVotedOnAnswer(ctx, questionID, answerID, userID)
-	if err != nil {
-		return err
-	}
+func (r *MongoRepository) Close(ctx context.Context) error {
+	return r.client.Disconnect(ctx)
+}
 
-	if hasVoted {
-		if voteType == "upvote" {
-			return errors.New("already upvoted")
-		}
-		// Remove the previous downvote before adding upvote
-		if voteType == "downvote" {
-			update := bson.M{
-				"$inc":  bson.M{"answers.$[elem].downvotes": -1},
-				"$pull": bson.M{"answers.$[elem].voted_by": bson.M{"user_id": userID}},
-			}
-
-			arrayFilters := options.Update().SetArrayFilters(options.ArrayFilters{
-				Filters: []interface{}{bson.M{"elem._id": aID}},
-			})
-
-			if _, err := r.questions.UpdateOne(ctx, bson.M{"_id": qID}, update, arrayFilters); err != nil {
-				return err
-			}
-		}
+func (r *MongoRepository) GetUserIDFromQuestionID(ctx context.Context, questionID string) (string, error) {
+	qID, err := ParseID("question_id", questionID)
+	if err != nil {
+		return "", err
 	}
 
-	// Add upvote and record the vote
-	update := bson.M{
-		"$inc": bson.M{"answers.$[elem].upvotes": 1},
-		"$push": bson.M{
-			"answers.$[elem].voted_by": models.Vote{
-				UserID:   userID,
-				VoteType: "upvote",
-				VotedAt:  time.Now(),
-			},
-		},
+	var result struct {
+		UserID string `bson:"user_id"`
 	}
 
-	arrayFilters := options.Update().SetArrayFilters(options.ArrayFilters{
-		Filters: []interface{}{bson.M{"elem._id": aID}},
-	})
-
-	result, err := r.questions.UpdateOne(ctx, bson.M{"_id": qID}, update, arrayFilters)
+	err = r.questions.FindOne(ctx, bson.M{"_id": qID, "deleted_at": nil}, options.FindOne().SetProjection(bson.M{"user_id": 1})).Decode(&result)
 	if err != nil {
-		return err
-	}
-
-	if result.MatchedCount == 0 {
-		return errors.New("question or answer not found")
+		if err == mongo.ErrNoDocuments {
+			return "", errs.NotFoundf("question not found")
+		}
+		return "", dbError(err)
 	}
 
-	return nil
+	return result.UserID, nil
 }
 
-func (r *MongoRepository) GetFlaggedQuestions(ctx context.Context) ([]models.Question, int32, error) {
+func (r *MongoRepository) GetFlaggedQuestions(ctx context.Context, page models.Page) (*models.QuestionPage, error) {
 
 	// Create match stage for flagged questions
-	match := bson.M{"is_flagged": true}
+	match := bson.M{"is_flagged": true, "deleted_at": nil}
 
 	// Get total count of flagged questions
 	totalCount, err := r.questions.CountDocuments(ctx, match)
 	if err != nil {
-		return nil, 0, err
+		return nil, dbError(err)
 	}
 
-	opts := options.Find().
-		SetSort(bson.D{{Key: "created_at", Value: -1}})
-
-	cursor, err := r.questions.Find(ctx, match, opts)
+	cursor, err := r.questions.Find(ctx, pageFilter(match, page), pageOptions(page))
 	if err != nil {
-		return nil, 0, err
+		return nil, dbError(err)
 	}
 	defer cursor.Close(ctx)
 
 	var questions []models.Question
 	if err = cursor.All(ctx, &questions); err != nil {
-		return nil, 0, err
-	}
-
-	return questions, int32(totalCount), nil
-}
-
-func (r *MongoRepository) GetFlaggedAnswers(ctx context.Context) ([]models.Answer, int32, error) {
-
-	// Pipeline to unwind answers and match flagged ones
-	pipeline := mongo.Pipeline{
-		{{Key: "$unwind", Value: "$answers"}},
-		{{Key: "$match", Value: bson.M{"answers.is_flagged": true}}},
-		{{Key: "$group", Value: bson.M{
-			"_id":     nil,
-			"total":   bson.M{"$sum": 1},
-			"answers": bson.M{"$push": "$answers"},
-		}}},
-	}
-
-	// Execute pipeline to get total count and answers
-	cursor, err := r.questions.Aggregate(ctx, pipeline)
-	if err != nil {
-		return nil, 0, err
-	}
-	defer cursor.Close(ctx)
-
-	var result struct {
-		Total   int32           `bson:"total"`
-		Answers []models.Answer `bson:"answers"`
-	}
-
-	if cursor.Next(ctx) {
-		if err := cursor.Decode(&result); err != nil {
-			return nil, 0, err
-		}
+		return nil, dbError(err)
 	}
 
-	return result.Answers, result.Total, nil
+	result := questionPage(questions, page)
+	result.Total = totalCount
+	return result, nil
 }
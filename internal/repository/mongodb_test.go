@@ -0,0 +1,52 @@
+package mongodb_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+	"github.com/liju-github/ContentService/internal/repository/repotest"
+)
+
+// TestConformance runs the suite against the MongoDB server at
+// MONGO_TEST_URI, giving each subtest a database of its own that is dropped
+// afterwards. It is skipped when the variable is not set.
+func TestConformance(t *testing.T) {
+	uri := os.Getenv("MONGO_TEST_URI")
+	if uri == "" {
+		t.Skip("MONGO_TEST_URI is not set")
+	}
+
+	repotest.Run(t, func(t *testing.T) mongodb.Repository {
+		database := fmt.Sprintf("content_test_%s", primitive.NewObjectID().Hex())
+		repo, err := mongodb.NewMongoRepository(&models.MongoConfig{URI: uri, Database: database})
+		if err != nil {
+			t.Fatalf("connecting to MongoDB: %v", err)
+		}
+
+		t.Cleanup(func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			repo.Close(ctx)
+
+			client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+			if err != nil {
+				t.Errorf("connecting to MongoDB: %v", err)
+				return
+			}
+			defer client.Disconnect(ctx)
+			if err := client.Database(database).Drop(ctx); err != nil {
+				t.Errorf("dropping %s: %v", database, err)
+			}
+		})
+		return repo
+	})
+}
@@ -0,0 +1,102 @@
+package mongodb
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// pageSort is the stable order shared by every paginated listing.
+var pageSort = bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}
+
+// pageFilter restricts filter to documents that sort after the page cursor.
+func pageFilter(filter bson.M, page models.Page) bson.M {
+	return pageFilterBy("created_at", filter, page)
+}
+
+// pageFilterBy is pageFilter for listings ordered by (field, _id) instead of
+// (created_at, _id).
+func pageFilterBy(field string, filter bson.M, page models.Page) bson.M {
+	if page.After == nil {
+		return filter
+	}
+
+	after := bson.M{"$or": []bson.M{
+		{field: bson.M{"$lt": page.After.CreatedAt}},
+		{field: page.After.CreatedAt, "_id": bson.M{"$lt": page.After.ID}},
+	}}
+	if len(filter) == 0 {
+		return after
+	}
+
+	return bson.M{"$and": []bson.M{filter, after}}
+}
+
+// pageOptions fetches one document more than the page holds, which tells
+// us whether there is a next page without a second query.
+func pageOptions(page models.Page) *options.FindOptions {
+	return options.Find().
+		SetSort(pageSort).
+		SetLimit(int64(page.Limit) + 1)
+}
+
+// trimPage cuts the extra document fetched by pageOptions and returns the
+// cursor of the last kept item when there are more results.
+func trimPage(count int, page models.Page, cursorAt func(i int) models.Cursor) (int, *models.Cursor) {
+	if count <= page.Limit {
+		return count, nil
+	}
+
+	next := cursorAt(page.Limit - 1)
+	return page.Limit, &next
+}
+
+func questionPage(questions []models.Question, page models.Page) *models.QuestionPage {
+	n, next := trimPage(len(questions), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: questions[i].CreatedAt, ID: questions[i].ID}
+	})
+	return &models.QuestionPage{Questions: questions[:n], Next: next}
+}
+
+func answerPage(answers []models.Answer, page models.Page) *models.AnswerPage {
+	n, next := trimPage(len(answers), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: answers[i].CreatedAt, ID: answers[i].ID}
+	})
+	return &models.AnswerPage{Answers: answers[:n], Next: next}
+}
+
+func commentPage(comments []models.Comment, page models.Page) *models.CommentPage {
+	n, next := trimPage(len(comments), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: comments[i].CreatedAt, ID: comments[i].ID}
+	})
+	return &models.CommentPage{Comments: comments[:n], Next: next}
+}
+
+func tagPage(tags []models.Tag, page models.Page) *models.TagPage {
+	n, next := trimPage(len(tags), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: tags[i].CreatedAt, ID: tags[i].ID}
+	})
+	return &models.TagPage{Tags: tags[:n], Next: next}
+}
+
+func followPage(follows []models.Follow, page models.Page) *models.FollowPage {
+	n, next := trimPage(len(follows), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: follows[i].CreatedAt, ID: follows[i].ID}
+	})
+	return &models.FollowPage{Follows: follows[:n], Next: next}
+}
+
+func moderationPage(items []models.ModerationItem, page models.Page) *models.ModerationPage {
+	n, next := trimPage(len(items), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: items[i].FlaggedAt, ID: items[i].TargetID}
+	})
+	return &models.ModerationPage{Items: items[:n], Next: next}
+}
+
+func auditPage(events []models.AuditEvent, page models.Page) *models.AuditPage {
+	n, next := trimPage(len(events), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: events[i].CreatedAt, ID: events[i].ID}
+	})
+	return &models.AuditPage{Events: events[:n], Next: next}
+}
@@ -0,0 +1,100 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// PurgeDeleted removes one post at a time. Each post is deleted on the
+// condition that it is still an expired tombstone, and its children only once
+// that delete matched, so a post restored while the purge runs is kept along
+// with its answers, comments and revisions.
+func (r *MongoRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	expired := bson.M{"deleted_at": bson.M{"$lt": before}}
+	questionIDs, err := r.tombstoneIDs(ctx, r.questions.Name(), expired)
+	if err != nil {
+		return 0, err
+	}
+
+	var purged int64
+	for _, id := range questionIDs {
+		result, err := r.questions.DeleteOne(ctx, withFilter(expired, bson.M{"_id": id}))
+		if err != nil {
+			return purged, dbError(err)
+		}
+		if result.DeletedCount == 0 {
+			continue
+		}
+		purged++
+
+		byQuestion := bson.M{"question_id": id}
+		if _, err := r.comments.DeleteMany(ctx, byQuestion); err != nil {
+			return purged, dbError(err)
+		}
+		if _, err := r.revisions.DeleteMany(ctx, byQuestion); err != nil {
+			return purged, dbError(err)
+		}
+		result, err = r.answers.DeleteMany(ctx, byQuestion)
+		if err != nil {
+			return purged, dbError(err)
+		}
+		purged += result.DeletedCount
+	}
+
+	expired = bson.M{"deleted_at": bson.M{"$lt": before}, "deleted_with_question": bson.M{"$ne": true}}
+	answerIDs, err := r.tombstoneIDs(ctx, r.answers.Name(), expired)
+	if err != nil {
+		return purged, err
+	}
+
+	for _, id := range answerIDs {
+		result, err := r.answers.DeleteOne(ctx, withFilter(expired, bson.M{"_id": id}))
+		if err != nil {
+			return purged, dbError(err)
+		}
+		if result.DeletedCount == 0 {
+			continue
+		}
+		purged++
+
+		byAnswer := bson.M{"target_type": models.TargetAnswer, "target_id": id}
+		if _, err := r.comments.DeleteMany(ctx, byAnswer); err != nil {
+			return purged, dbError(err)
+		}
+		if _, err := r.revisions.DeleteMany(ctx, byAnswer); err != nil {
+			return purged, dbError(err)
+		}
+	}
+
+	return purged, nil
+}
+
+// tombstoneIDs returns the IDs of the documents in collection that match
+// filter.
+func (r *MongoRepository) tombstoneIDs(ctx context.Context, collection string, filter bson.M) ([]primitive.ObjectID, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 1})
+	cursor, err := r.client.Database(r.database).Collection(collection).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var documents []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err = cursor.All(ctx, &documents); err != nil {
+		return nil, dbError(err)
+	}
+
+	ids := make([]primitive.ObjectID, len(documents))
+	for i, document := range documents {
+		ids[i] = document.ID
+	}
+	return ids, nil
+}
@@ -0,0 +1,872 @@
+// Package repotest is a conformance suite for implementations of the
+// repository interface. Every backend runs it from its own tests so that
+// they stay interchangeable:
+//
+//	func TestConformance(t *testing.T) {
+//		repotest.Run(t, func(t *testing.T) mongodb.Repository {
+//			return memory.New()
+//		})
+//	}
+package repotest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+// NewRepository returns an empty repository for a single test.
+type NewRepository func(t *testing.T) mongodb.Repository
+
+// Run runs the whole suite, calling newRepo for a fresh repository in each
+// subtest.
+func Run(t *testing.T, newRepo NewRepository) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, repo mongodb.Repository)
+	}{
+		{"Questions", testQuestions},
+		{"Pagination", testPagination},
+		{"DeleteRestoreQuestion", testDeleteRestoreQuestion},
+		{"Answers", testAnswers},
+		{"Votes", testVotes},
+		{"ConcurrentVotes", testConcurrentVotes},
+		{"AcceptAnswer", testAcceptAnswer},
+		{"Tags", testTags},
+		{"Revisions", testRevisions},
+		{"Comments", testComments},
+		{"Follows", testFollows},
+		{"Feed", testFeed},
+		{"Flags", testFlags},
+		{"Moderation", testModeration},
+		{"Search", testSearch},
+		{"Audit", testAudit},
+		{"Purge", testPurge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepo(t))
+		})
+	}
+}
+
+var ctx = context.Background()
+
+// wantKind fails the test unless err is an errs error of the given kind.
+func wantKind(t *testing.T, err error, kind errs.Kind) {
+	t.Helper()
+	if !errs.Is(err, kind) {
+		t.Fatalf("got error %v, want %v", err, kind)
+	}
+}
+
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func postQuestion(t *testing.T, repo mongodb.Repository, userID, title string, tags ...string) *models.Question {
+	t.Helper()
+	question := &models.Question{
+		UserID:   userID,
+		Question: title,
+		Details:  "details of " + title,
+		Tags:     tags,
+		Flags:    []models.Flag{},
+	}
+	must(t, repo.PostQuestion(ctx, question))
+	return question
+}
+
+func postAnswer(t *testing.T, repo mongodb.Repository, questionID primitive.ObjectID, userID, text string) *models.Answer {
+	t.Helper()
+	answer := &models.Answer{UserID: userID, Answer: text}
+	must(t, repo.PostAnswer(ctx, questionID.Hex(), answer))
+	return answer
+}
+
+func getQuestion(t *testing.T, repo mongodb.Repository, id primitive.ObjectID) *models.Question {
+	t.Helper()
+	question, err := repo.GetQuestionByID(ctx, id.Hex())
+	must(t, err)
+	return question
+}
+
+func getAnswer(t *testing.T, repo mongodb.Repository, answer *models.Answer) *models.Answer {
+	t.Helper()
+	got, err := repo.GetAnswerByID(ctx, answer.QuestionID.Hex(), answer.ID.Hex())
+	must(t, err)
+	return got
+}
+
+func testQuestions(t *testing.T, repo mongodb.Repository) {
+	question := postQuestion(t, repo, "alice", "How do channels work?", "go")
+	if question.ID.IsZero() || question.CreatedAt.IsZero() {
+		t.Fatalf("PostQuestion did not set ID and CreatedAt: %+v", question)
+	}
+
+	got := getQuestion(t, repo, question.ID)
+	if got.Question != question.Question || got.UserID != "alice" || len(got.Tags) != 1 || got.Tags[0] != "go" {
+		t.Errorf("GetQuestionByID = %+v, want %+v", got, question)
+	}
+	if got.IsAnswered || got.AnswerCount != 0 {
+		t.Errorf("new question is answered or has answers: %+v", got)
+	}
+
+	owner, err := repo.GetUserIDFromQuestionID(ctx, question.ID.Hex())
+	must(t, err)
+	if owner != "alice" {
+		t.Errorf("GetUserIDFromQuestionID = %q, want alice", owner)
+	}
+
+	_, err = repo.GetQuestionByID(ctx, primitive.NewObjectID().Hex())
+	wantKind(t, err, errs.NotFound)
+	_, err = repo.GetQuestionByID(ctx, "not-an-id")
+	wantKind(t, err, errs.InvalidArgument)
+
+	// Only an accepted answer makes a question answered
+	wantKind(t, repo.MarkQuestionAsAnswered(ctx, question.ID.Hex()), errs.FailedPrecondition)
+	if getQuestion(t, repo, question.ID).IsAnswered {
+		t.Error("MarkQuestionAsAnswered marked a question without an accepted answer")
+	}
+	wantKind(t, repo.MarkQuestionAsAnswered(ctx, primitive.NewObjectID().Hex()), errs.NotFound)
+
+	byTag, err := repo.GetQuestionsByTags(ctx, []string{"rust", "go"}, models.Page{Limit: 10})
+	must(t, err)
+	if len(byTag.Questions) != 1 || byTag.Questions[0].ID != question.ID {
+		t.Errorf("GetQuestionsByTags = %+v, want the question", byTag.Questions)
+	}
+
+	byWord, err := repo.GetQuestionsByWord(ctx, "channels", models.Page{Limit: 10})
+	must(t, err)
+	if len(byWord.Questions) != 1 {
+		t.Errorf("GetQuestionsByWord found %d questions, want 1", len(byWord.Questions))
+	}
+}
+
+func testPagination(t *testing.T, repo mongodb.Repository) {
+	var ids []primitive.ObjectID
+	for i := 0; i < 5; i++ {
+		ids = append(ids, postQuestion(t, repo, "alice", fmt.Sprintf("question %d", i)).ID)
+	}
+	postQuestion(t, repo, "bob", "someone else's question")
+
+	var seen []primitive.ObjectID
+	page := models.Page{Limit: 2}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("pagination did not end")
+		}
+
+		result, err := repo.GetQuestionsByUserID(ctx, "alice", page)
+		must(t, err)
+		for _, q := range result.Questions {
+			seen = append(seen, q.ID)
+		}
+		if result.Next == nil {
+			break
+		}
+		if len(result.Questions) != page.Limit {
+			t.Fatalf("got a short page of %d before the end", len(result.Questions))
+		}
+		page.After = result.Next
+	}
+
+	if len(seen) != len(ids) {
+		t.Fatalf("paged through %d questions, want %d", len(seen), len(ids))
+	}
+	for i, id := range seen {
+		if want := ids[len(ids)-1-i]; id != want {
+			t.Errorf("question %d = %s, want %s (newest first)", i, id.Hex(), want.Hex())
+		}
+	}
+}
+
+func testDeleteRestoreQuestion(t *testing.T, repo mongodb.Repository) {
+	question := postQuestion(t, repo, "alice", "Delete me")
+	answer := postAnswer(t, repo, question.ID, "bob", "an answer")
+
+	must(t, repo.DeleteQuestion(ctx, question.ID.Hex(), "mod", "spam"))
+	wantKind(t, repo.DeleteQuestion(ctx, question.ID.Hex(), "mod", "spam"), errs.NotFound)
+
+	_, err := repo.GetQuestionByID(ctx, question.ID.Hex())
+	wantKind(t, err, errs.NotFound)
+	_, err = repo.GetAnswerByID(ctx, question.ID.Hex(), answer.ID.Hex())
+	wantKind(t, err, errs.NotFound)
+
+	deleted, err := repo.GetDeletedQuestion(ctx, question.ID.Hex())
+	must(t, err)
+	if deleted.DeletedAt == nil || deleted.DeletedBy != "mod" || deleted.DeleteReason != "spam" {
+		t.Errorf("tombstone = %+v, want deleted by mod for spam", deleted)
+	}
+
+	// Answers deleted with their question only come back with it
+	_, err = repo.RestoreAnswer(ctx, question.ID.Hex(), answer.ID.Hex())
+	wantKind(t, err, errs.NotFound)
+
+	restored, err := repo.RestoreQuestion(ctx, question.ID.Hex())
+	must(t, err)
+	if restored.DeletedAt != nil || restored.DeletedBy != "" {
+		t.Errorf("restored question is still a tombstone: %+v", restored)
+	}
+	if got := getAnswer(t, repo, answer); got.DeletedAt != nil || got.DeletedWithQuestion {
+		t.Errorf("answer was not restored with its question: %+v", got)
+	}
+
+	_, err = repo.RestoreQuestion(ctx, question.ID.Hex())
+	wantKind(t, err, errs.NotFound)
+	_, err = repo.GetDeletedQuestion(ctx, question.ID.Hex())
+	wantKind(t, err, errs.NotFound)
+}
+
+func testAnswers(t *testing.T, repo mongodb.Repository) {
+	question := postQuestion(t, repo, "alice", "Answer me")
+
+	err := repo.PostAnswer(ctx, primitive.NewObjectID().Hex(), &models.Answer{UserID: "bob", Answer: "lost"})
+	wantKind(t, err, errs.NotFound)
+
+	first := postAnswer(t, repo, question.ID, "bob", "first")
+	second := postAnswer(t, repo, question.ID, "carol", "second")
+	if first.QuestionID != question.ID {
+		t.Errorf("PostAnswer did not set QuestionID")
+	}
+	if got := getQuestion(t, repo, question.ID).AnswerCount; got != 2 {
+		t.Errorf("AnswerCount = %d, want 2", got)
+	}
+
+	answers, err := repo.GetAnswersByQuestionID(ctx, question.ID.Hex())
+	must(t, err)
+	if len(answers) != 2 || answers[0].ID != first.ID || answers[1].ID != second.ID {
+		t.Errorf("GetAnswersByQuestionID is not oldest first: %+v", answers)
+	}
+
+	owner, err := repo.GetAnswerOwnerID(ctx, question.ID.Hex(), second.ID.Hex())
+	must(t, err)
+	if owner != "carol" {
+		t.Errorf("GetAnswerOwnerID = %q, want carol", owner)
+	}
+
+	// An answer addressed through the wrong question does not exist
+	_, err = repo.GetAnswerByID(ctx, primitive.NewObjectID().Hex(), first.ID.Hex())
+	wantKind(t, err, errs.NotFound)
+
+	must(t, repo.DeleteAnswer(ctx, question.ID.Hex(), first.ID.Hex(), "bob", ""))
+	wantKind(t, repo.DeleteAnswer(ctx, question.ID.Hex(), first.ID.Hex(), "bob", ""), errs.NotFound)
+	if got := getQuestion(t, repo, question.ID).AnswerCount; got != 1 {
+		t.Errorf("AnswerCount after delete = %d, want 1", got)
+	}
+
+	deleted, err := repo.GetDeletedAnswer(ctx, question.ID.Hex(), first.ID.Hex())
+	must(t, err)
+	if deleted.DeletedBy != "bob" {
+		t.Errorf("DeletedBy = %q, want bob", deleted.DeletedBy)
+	}
+
+	restored, err := repo.RestoreAnswer(ctx, question.ID.Hex(), first.ID.Hex())
+	must(t, err)
+	if restored.DeletedAt != nil {
+		t.Errorf("restored answer is still deleted")
+	}
+	if got := getQuestion(t, repo, question.ID).AnswerCount; got != 2 {
+		t.Errorf("AnswerCount after restore = %d, want 2", got)
+	}
+	_, err = repo.GetDeletedAnswer(ctx, question.ID.Hex(), first.ID.Hex())
+	wantKind(t, err, errs.NotFound)
+}
+
+func testVotes(t *testing.T, repo mongodb.Repository) {
+	question := postQuestion(t, repo, "alice", "Vote on answers")
+	answer := postAnswer(t, repo, question.ID, "bob", "vote for me")
+	qID, aID := question.ID.Hex(), answer.ID.Hex()
+
+	must(t, repo.UpvoteAnswer(ctx, qID, aID, "carol"))
+	wantKind(t, repo.UpvoteAnswer(ctx, qID, aID, "carol"), errs.AlreadyExists)
+
+	voted, voteType, err := repo.HasUserVotedOnAnswer(ctx, qID, aID, "carol")
+	must(t, err)
+	if !voted || voteType != models.VoteTypeUpvote {
+		t.Errorf("HasUserVotedOnAnswer = %v, %q, want an upvote", voted, voteType)
+	}
+
+	must(t, repo.DownvoteAnswer(ctx, qID, aID, "carol"))
+	if got := getAnswer(t, repo, answer); got.Upvotes != 0 || got.Downvotes != 1 || len(got.Vote) != 1 {
+		t.Errorf("after switching: %d up, %d down, %d votes, want 0, 1, 1", got.Upvotes, got.Downvotes, len(got.Vote))
+	}
+	wantKind(t, repo.DownvoteAnswer(ctx, qID, aID, "carol"), errs.AlreadyExists)
+
+	got, err := repo.ToggleVote(ctx, qID, aID, "carol", models.VoteTypeUpvote)
+	must(t, err)
+	if got.Upvotes != 1 || got.Downvotes != 0 || len(got.Vote) != 1 {
+		t.Errorf("after toggling to an upvote: %d up, %d down, %d votes, want 1, 0, 1", got.Upvotes, got.Downvotes, len(got.Vote))
+	}
+
+	got, err = repo.ToggleVote(ctx, qID, aID, "carol", models.VoteTypeUpvote)
+	must(t, err)
+	if got.Upvotes != 0 || got.Downvotes != 0 || len(got.Vote) != 0 {
+		t.Errorf("after toggling the upvote off: %d up, %d down, %d votes, want none", got.Upvotes, got.Downvotes, len(got.Vote))
+	}
+
+	got, err = repo.ToggleVote(ctx, qID, aID, "carol", models.VoteTypeDownvote)
+	must(t, err)
+	if got.Upvotes != 0 || got.Downvotes != 1 || len(got.Vote) != 1 {
+		t.Errorf("after toggling a downvote on: %d up, %d down, %d votes, want 0, 1, 1", got.Upvotes, got.Downvotes, len(got.Vote))
+	}
+
+	_, err = repo.ToggleVote(ctx, qID, primitive.NewObjectID().Hex(), "carol", models.VoteTypeUpvote)
+	wantKind(t, err, errs.NotFound)
+
+	wantKind(t, repo.UpvoteAnswer(ctx, qID, primitive.NewObjectID().Hex(), "carol"), errs.NotFound)
+}
+
+func testConcurrentVotes(t *testing.T, repo mongodb.Repository) {
+	question := postQuestion(t, repo, "alice", "Popular answer")
+	answer := postAnswer(t, repo, question.ID, "bob", "everyone likes this")
+	qID, aID := question.ID.Hex(), answer.ID.Hex()
+
+	const voters = 20
+	var wg sync.WaitGroup
+	for i := 0; i < voters; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			if err := repo.UpvoteAnswer(ctx, qID, aID, fmt.Sprintf("voter-%d", i)); err != nil {
+				t.Errorf("upvote %d: %v", i, err)
+			}
+		}(i)
+		// The same user voting again at the same time is counted once
+		go func() {
+			defer wg.Done()
+			if err := repo.UpvoteAnswer(ctx, qID, aID, "eager"); err != nil && !errs.Is(err, errs.AlreadyExists) {
+				t.Errorf("repeated upvote: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := getAnswer(t, repo, answer); got.Upvotes != voters+1 || len(got.Vote) != voters+1 {
+		t.Errorf("got %d upvotes and %d votes, want %d", got.Upvotes, len(got.Vote), voters+1)
+	}
+
+	// Concurrent toggles by one user keep the counter in step with the ledger
+	for i := 0; i < voters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := repo.ToggleVote(ctx, qID, aID, "toggler", models.VoteTypeUpvote); err != nil && !errs.Is(err, errs.Unavailable) {
+				t.Errorf("toggle: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	got := getAnswer(t, repo, answer)
+	if got.Upvotes != len(got.Vote) {
+		t.Errorf("after concurrent toggles: %d upvotes but %d votes", got.Upvotes, len(got.Vote))
+	}
+}
+
+func testAcceptAnswer(t *testing.T, repo mongodb.Repository) {
+	question := postQuestion(t, repo, "alice", "Which answer?")
+	answer := postAnswer(t, repo, question.ID, "bob", "this one")
+	qID := question.ID.Hex()
+
+	wantKind(t, repo.UnacceptAnswer(ctx, qID), errs.NotFound)
+	wantKind(t, repo.AcceptAnswer(ctx, qID, primitive.NewObjectID().Hex()), errs.NotFound)
+
+	must(t, repo.AcceptAnswer(ctx, qID, answer.ID.Hex()))
+	got := getQuestion(t, repo, question.ID)
+	if got.AcceptedAnswerID == nil || *got.AcceptedAnswerID != answer.ID || !got.IsAnswered {
+		t.Errorf("after AcceptAnswer: %+v", got)
+	}
+	must(t, repo.MarkQuestionAsAnswered(ctx, qID))
+
+	must(t, repo.UnacceptAnswer(ctx, qID))
+	if got := getQuestion(t, repo, question.ID); got.AcceptedAnswerID != nil || got.IsAnswered {
+		t.Errorf("after UnacceptAnswer: %+v", got)
+	}
+
+	// Deleting the accepted answer unaccepts it
+	must(t, repo.AcceptAnswer(ctx, qID, answer.ID.Hex()))
+	must(t, repo.DeleteAnswer(ctx, qID, answer.ID.Hex(), "bob", ""))
+	if got := getQuestion(t, repo, question.ID); got.AcceptedAnswerID != nil || got.IsAnswered {
+		t.Errorf("deleted answer is still accepted: %+v", got)
+	}
+
+	wantKind(t, repo.UnacceptAnswer(ctx, primitive.NewObjectID().Hex()), errs.NotFound)
+}
+
+func testTags(t *testing.T, repo mongodb.Repository) {
+	must(t, repo.AddTag(ctx, &models.Tag{Name: "go", Description: "The Go language"}))
+	wantKind(t, repo.AddTag(ctx, &models.Tag{Name: "go"}), errs.AlreadyExists)
+
+	tag, err := repo.UpdateTag(ctx, "go", "Go")
+	must(t, err)
+	if tag.Description != "Go" {
+		t.Errorf("UpdateTag returned description %q", tag.Description)
+	}
+	_, err = repo.UpdateTag(ctx, "missing", "")
+	wantKind(t, err, errs.NotFound)
+
+	must(t, repo.EnsureTags(ctx, []string{"go", "rust"}))
+	if tag, err := repo.GetTag(ctx, "go"); err != nil || tag.Description != "Go" {
+		t.Errorf("EnsureTags changed an existing tag: %+v, %v", tag, err)
+	}
+
+	tags, err := repo.GetTagsByNames(ctx, []string{"go", "rust", "missing"})
+	must(t, err)
+	if len(tags) != 2 {
+		t.Errorf("GetTagsByNames found %d tags, want 2", len(tags))
+	}
+
+	listed, err := repo.ListTags(ctx, models.Page{Limit: 10})
+	must(t, err)
+	if len(listed.Tags) != 2 || listed.Next != nil {
+		t.Errorf("ListTags = %+v, want both tags on one page", listed)
+	}
+
+	must(t, repo.RemoveTag(ctx, "rust"))
+	wantKind(t, repo.RemoveTag(ctx, "rust"), errs.NotFound)
+	_, err = repo.GetTag(ctx, "rust")
+	wantKind(t, err, errs.NotFound)
+}
+
+func testRevisions(t *testing.T, repo mongodb.Repository) {
+	question := postQuestion(t, repo, "alice", "Original title", "go")
+
+	for i, title := range []string{"Second title", "Third title"} {
+		edited, err := repo.EditQuestion(ctx, question.ID.Hex(), &models.Revision{
+			EditorID: "bob",
+			Question: title,
+			Details:  "new details",
+			Tags:     []string{"go"},
+		})
+		must(t, err)
+		if edited.Question != title || edited.RevisionCount != i+1 || edited.LastEditedBy != "bob" {
+			t.Errorf("edit %d returned %+v", i+1, edited)
+		}
+	}
+
+	revisions, err := repo.GetRevisions(ctx, models.TargetQuestion, question.ID.Hex())
+	must(t, err)
+	want := []string{"Original title", "Second title", "Third title"}
+	if len(revisions) != len(want) {
+		t.Fatalf("got %d revisions, want %d", len(revisions), len(want))
+	}
+	for i, revision := range revisions {
+		if revision.Revision != i+1 || revision.Question != want[i] {
+			t.Errorf("revision %d = %d %q, want %d %q", i, revision.Revision, revision.Question, i+1, want[i])
+		}
+	}
+	if revisions[0].EditorID != "alice" {
+		t.Errorf("original revision is by %q, want the author", revisions[0].EditorID)
+	}
+
+	answer := postAnswer(t, repo, question.ID, "carol", "first draft")
+	edited, err := repo.EditAnswer(ctx, question.ID.Hex(), answer.ID.Hex(), &models.Revision{EditorID: "carol", Answer: "final"})
+	must(t, err)
+	if edited.Answer != "final" || edited.RevisionCount != 1 {
+		t.Errorf("EditAnswer returned %+v", edited)
+	}
+
+	_, err = repo.EditAnswer(ctx, question.ID.Hex(), primitive.NewObjectID().Hex(), &models.Revision{})
+	wantKind(t, err, errs.NotFound)
+
+	none, err := repo.GetRevisions(ctx, models.TargetAnswer, primitive.NewObjectID().Hex())
+	must(t, err)
+	if none == nil || len(none) != 0 {
+		t.Errorf("GetRevisions of an unedited post = %#v, want an empty slice", none)
+	}
+}
+
+func testComments(t *testing.T, repo mongodb.Repository) {
+	question := postQuestion(t, repo, "alice", "Discuss")
+	other := postQuestion(t, repo, "alice", "Elsewhere")
+
+	root := &models.Comment{TargetType: models.TargetQuestion, TargetID: question.ID, QuestionID: question.ID, UserID: "bob", Body: "root"}
+	must(t, repo.PostComment(ctx, root))
+
+	reply := &models.Comment{TargetType: models.TargetQuestion, TargetID: question.ID, QuestionID: question.ID, ParentID: &root.ID, UserID: "carol", Body: "reply"}
+	must(t, repo.PostComment(ctx, reply))
+	if len(reply.Ancestors) != 1 || reply.Ancestors[0] != root.ID {
+		t.Errorf("reply ancestors = %v, want [%s]", reply.Ancestors, root.ID.Hex())
+	}
+
+	stray := &models.Comment{TargetType: models.TargetQuestion, TargetID: other.ID, QuestionID: other.ID, ParentID: &root.ID, UserID: "carol", Body: "stray"}
+	wantKind(t, repo.PostComment(ctx, stray), errs.InvalidArgument)
+
+	missing := primitive.NewObjectID()
+	orphan := &models.Comment{TargetType: models.TargetQuestion, TargetID: missing, QuestionID: missing, UserID: "bob", Body: "orphan"}
+	wantKind(t, repo.PostComment(ctx, orphan), errs.NotFound)
+
+	if got := getQuestion(t, repo, question.ID).CommentCount; got != 2 {
+		t.Errorf("CommentCount = %d, want 2", got)
+	}
+
+	edited, err := repo.EditComment(ctx, reply.ID.Hex(), "edited")
+	must(t, err)
+	if edited.Body != "edited" {
+		t.Errorf("EditComment returned body %q", edited.Body)
+	}
+
+	listed, err := repo.ListComments(ctx, models.TargetQuestion, question.ID.Hex(), models.Page{Limit: 10})
+	must(t, err)
+	if len(listed.Comments) != 2 || listed.Comments[0].ID != reply.ID {
+		t.Errorf("ListComments = %+v, want both comments, newest first", listed.Comments)
+	}
+
+	// Deleting a comment leaves a tombstone and keeps the replies to it
+	must(t, repo.DeleteComment(ctx, root.ID.Hex()))
+	_, err = repo.GetCommentByID(ctx, root.ID.Hex())
+	wantKind(t, err, errs.NotFound)
+	if got, err := repo.GetCommentByID(ctx, reply.ID.Hex()); err != nil || got.Body != "edited" {
+		t.Errorf("reply after deleting its parent = %+v, %v", got, err)
+	}
+	if got := getQuestion(t, repo, question.ID).CommentCount; got != 1 {
+		t.Errorf("CommentCount after deleting a comment = %d, want 1", got)
+	}
+
+	listed, err = repo.ListComments(ctx, models.TargetQuestion, question.ID.Hex(), models.Page{Limit: 10})
+	must(t, err)
+	if len(listed.Comments) != 2 {
+		t.Fatalf("ListComments after deleting a comment = %+v, want the reply and the tombstone", listed.Comments)
+	}
+	if tombstone := listed.Comments[1]; tombstone.ID != root.ID || tombstone.Body != models.DeletedCommentBody || tombstone.DeletedAt == nil {
+		t.Errorf("deleted comment = %+v, want a tombstone", tombstone)
+	}
+
+	wantKind(t, repo.DeleteComment(ctx, root.ID.Hex()), errs.NotFound)
+	_, err = repo.EditComment(ctx, root.ID.Hex(), "back")
+	wantKind(t, err, errs.NotFound)
+	replyToDeleted := &models.Comment{TargetType: models.TargetQuestion, TargetID: question.ID, QuestionID: question.ID, ParentID: &root.ID, UserID: "bob", Body: "late"}
+	wantKind(t, repo.PostComment(ctx, replyToDeleted), errs.NotFound)
+	wantKind(t, repo.FlagComment(ctx, root.ID.Hex(), models.Flag{UserID: "dave", Reason: models.FlagReasonSpam}, models.AutoHide{}), errs.NotFound)
+
+	// The comments of a deleted answer or question are no longer listed
+	answer := postAnswer(t, repo, question.ID, "carol", "answer with comments")
+	must(t, repo.PostComment(ctx, &models.Comment{TargetType: models.TargetAnswer, TargetID: answer.ID, QuestionID: question.ID, UserID: "bob", Body: "on the answer"}))
+	must(t, repo.DeleteAnswer(ctx, question.ID.Hex(), answer.ID.Hex(), "carol", ""))
+	_, err = repo.ListComments(ctx, models.TargetAnswer, answer.ID.Hex(), models.Page{Limit: 10})
+	wantKind(t, err, errs.NotFound)
+
+	must(t, repo.DeleteQuestion(ctx, question.ID.Hex(), "alice", ""))
+	_, err = repo.ListComments(ctx, models.TargetQuestion, question.ID.Hex(), models.Page{Limit: 10})
+	wantKind(t, err, errs.NotFound)
+}
+
+func testFollows(t *testing.T, repo mongodb.Repository) {
+	follow := &models.Follow{UserID: "alice", TargetType: models.FollowTag, Target: "go"}
+	must(t, repo.Follow(ctx, follow))
+	wantKind(t, repo.Follow(ctx, &models.Follow{UserID: "alice", TargetType: models.FollowTag, Target: "go"}), errs.AlreadyExists)
+	must(t, repo.Follow(ctx, &models.Follow{UserID: "alice", TargetType: models.FollowUser, Target: "bob"}))
+
+	tags, err := repo.ListFollows(ctx, "alice", models.FollowTag, models.Page{Limit: 10})
+	must(t, err)
+	if len(tags.Follows) != 1 || tags.Follows[0].ID != follow.ID {
+		t.Errorf("ListFollows(tag) = %+v, want the tag follow", tags.Follows)
+	}
+
+	all, err := repo.ListFollows(ctx, "alice", "", models.Page{Limit: 10})
+	must(t, err)
+	if len(all.Follows) != 2 {
+		t.Errorf("ListFollows = %d follows, want 2", len(all.Follows))
+	}
+
+	must(t, repo.Unfollow(ctx, "alice", models.FollowTag, "go"))
+	wantKind(t, repo.Unfollow(ctx, "alice", models.FollowTag, "go"), errs.NotFound)
+}
+
+func testFeed(t *testing.T, repo mongodb.Repository) {
+	must(t, repo.Follow(ctx, &models.Follow{UserID: "alice", TargetType: models.FollowTag, Target: "go"}))
+	must(t, repo.Follow(ctx, &models.Follow{UserID: "alice", TargetType: models.FollowUser, Target: "bob"}))
+
+	plain := postQuestion(t, repo, "carol", "Unrelated", "cooking")
+	tagged := postQuestion(t, repo, "carol", "Tagged", "go")
+	byAuthor := postQuestion(t, repo, "bob", "By a followed author", "go")
+	postQuestion(t, repo, "alice", "Alice's own question", "go")
+
+	query := models.FeedQuery{UserID: "alice", AsOf: time.Now().Add(time.Second), Limit: 2}
+	feed, err := repo.GetUserFeed(ctx, query)
+	must(t, err)
+	if !feed.HasMore || len(feed.Questions) != 2 || feed.Questions[0].ID != byAuthor.ID || feed.Questions[1].ID != tagged.ID {
+		t.Fatalf("first feed page = %+v, want the followed author then the followed tag", feed)
+	}
+
+	query.Offset = 2
+	feed, err = repo.GetUserFeed(ctx, query)
+	must(t, err)
+	if feed.HasMore || len(feed.Questions) != 1 || feed.Questions[0].ID != plain.ID {
+		t.Errorf("second feed page = %+v, want only the unrelated question", feed)
+	}
+
+	filtered, err := repo.GetUserFeed(ctx, models.FeedQuery{UserID: "alice", Tags: []string{"cooking"}, AsOf: query.AsOf, Limit: 10})
+	must(t, err)
+	if len(filtered.Questions) != 1 || filtered.Questions[0].ID != plain.ID {
+		t.Errorf("feed filtered by tag = %+v", filtered.Questions)
+	}
+}
+
+func testFlags(t *testing.T, repo mongodb.Repository) {
+	question := postQuestion(t, repo, "alice", "Flag me")
+	hide := models.AutoHide{Flags: 2}
+	flag := func(userID string) models.Flag {
+		return models.Flag{UserID: userID, Reason: models.FlagReasonSpam}
+	}
+
+	must(t, repo.FlagQuestion(ctx, question.ID.Hex(), flag("bob"), hide))
+	wantKind(t, repo.FlagQuestion(ctx, question.ID.Hex(), flag("bob"), hide), errs.AlreadyExists)
+	wantKind(t, repo.FlagQuestion(ctx, primitive.NewObjectID().Hex(), flag("bob"), hide), errs.NotFound)
+
+	got := getQuestion(t, repo, question.ID)
+	if !got.IsFlagged || got.IsHidden || len(got.Flags) != 1 || got.Flags[0].CreatedAt.IsZero() {
+		t.Errorf("after one flag: %+v", got)
+	}
+
+	must(t, repo.FlagQuestion(ctx, question.ID.Hex(), flag("carol"), hide))
+	if got := getQuestion(t, repo, question.ID); !got.IsHidden || !got.AutoHidden {
+		t.Errorf("question was not auto-hidden at the limit: %+v", got)
+	}
+
+	listed, err := repo.GetQuestionsByUserID(ctx, "alice", models.Page{Limit: 10})
+	must(t, err)
+	if len(listed.Questions) != 0 {
+		t.Errorf("hidden question is still listed")
+	}
+
+	flagged, err := repo.GetFlaggedQuestions(ctx, models.Page{Limit: 10})
+	must(t, err)
+	if flagged.Total != 1 || len(flagged.Questions) != 1 {
+		t.Errorf("GetFlaggedQuestions = %d of %d, want 1 of 1", len(flagged.Questions), flagged.Total)
+	}
+
+	// Weighted flags count towards the score limit
+	answer := postAnswer(t, repo, question.ID, "bob", "flag me too")
+	heavy := flag("mod")
+	heavy.Weight = 3
+	must(t, repo.FlagAnswer(ctx, question.ID.Hex(), answer.ID.Hex(), heavy, models.AutoHide{Score: 3}))
+	if got := getAnswer(t, repo, answer); !got.IsHidden || !got.AutoHidden {
+		t.Errorf("answer was not hidden by a heavy flag: %+v", got)
+	}
+
+	comment := &models.Comment{TargetType: models.TargetQuestion, TargetID: question.ID, QuestionID: question.ID, UserID: "bob", Body: "hi"}
+	must(t, repo.PostComment(ctx, comment))
+	must(t, repo.FlagComment(ctx, comment.ID.Hex(), flag("carol"), models.AutoHide{}))
+	wantKind(t, repo.FlagComment(ctx, comment.ID.Hex(), flag("carol"), models.AutoHide{}), errs.AlreadyExists)
+	wantKind(t, repo.FlagComment(ctx, primitive.NewObjectID().Hex(), flag("carol"), models.AutoHide{}), errs.NotFound)
+}
+
+func testModeration(t *testing.T, repo mongodb.Repository) {
+	question := postQuestion(t, repo, "alice", "Moderate me")
+	answer := postAnswer(t, repo, question.ID, "bob", "and me")
+	hide := models.AutoHide{Flags: 1}
+
+	must(t, repo.FlagQuestion(ctx, question.ID.Hex(), models.Flag{UserID: "carol", Reason: models.FlagReasonSpam}, hide))
+	must(t, repo.FlagAnswer(ctx, question.ID.Hex(), answer.ID.Hex(), models.Flag{UserID: "carol", Reason: models.FlagReasonOffensive}, models.AutoHide{}))
+
+	queue, err := repo.ListModerationQueue(ctx, "", "", models.Page{Limit: 10})
+	must(t, err)
+	if len(queue.Items) != 2 || queue.Items[0].TargetID != answer.ID || queue.Items[1].TargetID != question.ID {
+		t.Fatalf("queue = %+v, want the answer then the question", queue.Items)
+	}
+	if item := queue.Items[1]; item.TargetType != models.TargetQuestion || !item.AutoHidden || len(item.Flags) != 1 {
+		t.Errorf("question item = %+v", item)
+	}
+
+	spam, err := repo.ListModerationQueue(ctx, "", models.FlagReasonSpam, models.Page{Limit: 10})
+	must(t, err)
+	if len(spam.Items) != 1 || spam.Items[0].TargetID != question.ID {
+		t.Errorf("queue filtered by reason = %+v", spam.Items)
+	}
+
+	_, err = repo.ListModerationQueue(ctx, "tag", "", models.Page{Limit: 10})
+	wantKind(t, err, errs.InvalidArgument)
+
+	must(t, repo.ResolveFlags(ctx, models.TargetQuestion, question.ID.Hex(), models.FlagResolution{
+		Resolution:  models.FlagDismissed,
+		ModeratorID: "mod",
+	}))
+	wantKind(t, repo.ResolveFlags(ctx, models.TargetQuestion, question.ID.Hex(), models.FlagResolution{
+		Resolution:  models.FlagDismissed,
+		ModeratorID: "mod",
+	}), errs.NotFound)
+
+	got := getQuestion(t, repo, question.ID)
+	if got.IsFlagged || got.IsHidden || got.AutoHidden {
+		t.Errorf("dismissing did not reveal the auto-hidden question: %+v", got)
+	}
+	if len(got.Flags) != 1 || got.Flags[0].IsOpen() || got.Flags[0].ResolvedBy != "mod" {
+		t.Errorf("flag was not resolved: %+v", got.Flags)
+	}
+
+	item, err := repo.GetModerationItem(ctx, models.TargetQuestion, question.ID.Hex())
+	must(t, err)
+	if len(item.Flags) != 0 {
+		t.Errorf("resolved flags are still open: %+v", item.Flags)
+	}
+	_, err = repo.GetModerationItem(ctx, models.TargetAnswer, primitive.NewObjectID().Hex())
+	wantKind(t, err, errs.NotFound)
+
+	must(t, repo.ResolveFlags(ctx, models.TargetAnswer, answer.ID.Hex(), models.FlagResolution{
+		Resolution:  models.FlagHidden,
+		ModeratorID: "mod",
+	}))
+	if got := getAnswer(t, repo, answer); !got.IsHidden || got.AutoHidden {
+		t.Errorf("hiding did not hide the answer for good: %+v", got)
+	}
+
+	counts, err := repo.CountFlagsByReason(ctx, models.FlagCountQuery{IncludeResolved: true})
+	must(t, err)
+	if counts[models.FlagReasonSpam] != 1 || counts[models.FlagReasonOffensive] != 1 {
+		t.Errorf("CountFlagsByReason = %v", counts)
+	}
+	open, err := repo.CountFlagsByReason(ctx, models.FlagCountQuery{})
+	must(t, err)
+	if len(open) != 0 {
+		t.Errorf("CountFlagsByReason of open flags = %v, want none", open)
+	}
+
+	must(t, repo.AddWarning(ctx, &models.Warning{UserID: "bob", ModeratorID: "mod", TargetType: models.TargetAnswer, TargetID: answer.ID, Reason: "be nice"}))
+
+	// A resolution whose action failed is undone
+	must(t, repo.FlagQuestion(ctx, question.ID.Hex(), models.Flag{UserID: "dave", Reason: models.FlagReasonSpam}, models.AutoHide{}))
+	warned := models.FlagResolution{Resolution: models.FlagWarned, ModeratorID: "mod", Note: "spam"}
+	must(t, repo.ResolveFlags(ctx, models.TargetQuestion, question.ID.Hex(), warned))
+	must(t, repo.ReopenFlags(ctx, models.TargetQuestion, question.ID.Hex(), warned))
+	wantKind(t, repo.ReopenFlags(ctx, models.TargetQuestion, question.ID.Hex(), warned), errs.NotFound)
+
+	item, err = repo.GetModerationItem(ctx, models.TargetQuestion, question.ID.Hex())
+	must(t, err)
+	if len(item.Flags) != 1 || item.Flags[0].UserID != "dave" {
+		t.Errorf("open flags after reopening = %+v, want dave's", item.Flags)
+	}
+	if got := getQuestion(t, repo, question.ID); !got.IsFlagged || len(got.Flags) != 2 || got.Flags[0].IsOpen() {
+		t.Errorf("reopening changed other flags or left the question unflagged: %+v", got)
+	}
+}
+
+func testSearch(t *testing.T, repo mongodb.Repository) {
+	inTitle := postQuestion(t, repo, "alice", "Goroutine leaks")
+	viaAnswer := postQuestion(t, repo, "alice", "Why does my program hang?")
+	postAnswer(t, repo, viaAnswer.ID, "bob", "A goroutine is blocked forever")
+	postQuestion(t, repo, "alice", "Unrelated")
+
+	result, err := repo.SearchQuestionsAnswersUsers(ctx, "goroutine", 10, 0)
+	must(t, err)
+	if result.Total != 2 || len(result.Hits) != 2 {
+		t.Fatalf("search found %d hits of %d, want 2 of 2", len(result.Hits), result.Total)
+	}
+
+	found := make(map[primitive.ObjectID]models.SearchHit)
+	for _, hit := range result.Hits {
+		found[hit.Question.ID] = hit
+	}
+	if _, ok := found[inTitle.ID]; !ok {
+		t.Errorf("search missed the question with the term in its title")
+	}
+	if hit, ok := found[viaAnswer.ID]; !ok || hit.Answer == nil {
+		t.Errorf("search missed the question with a matching answer: %+v", hit)
+	}
+
+	first, err := repo.SearchQuestionsAnswersUsers(ctx, "goroutine", 1, 0)
+	must(t, err)
+	if len(first.Hits) != 1 || first.Next != 1 {
+		t.Errorf("first page has %d hits and next offset %d, want 1 and 1", len(first.Hits), first.Next)
+	}
+	paged, err := repo.SearchQuestionsAnswersUsers(ctx, "goroutine", 1, first.Next)
+	must(t, err)
+	if paged.Total != 2 || len(paged.Hits) != 1 || paged.Next != 0 {
+		t.Errorf("second page has %d hits of %d and next offset %d, want 1 of 2 and 0", len(paged.Hits), paged.Total, paged.Next)
+	}
+	if len(first.Hits) == 1 && len(paged.Hits) == 1 && first.Hits[0].Question.ID == paged.Hits[0].Question.ID {
+		t.Errorf("both pages returned question %s", first.Hits[0].Question.ID.Hex())
+	}
+
+	// Matching answers of hidden or deleted questions are not results, even
+	// when they would rank first
+	hidden := postQuestion(t, repo, "alice", "Hidden thread")
+	postAnswer(t, repo, hidden.ID, "bob", "goroutine goroutine goroutine")
+	must(t, repo.FlagQuestion(ctx, hidden.ID.Hex(), models.Flag{UserID: "carol", Reason: models.FlagReasonSpam}, models.AutoHide{Flags: 1}))
+	deleted := postQuestion(t, repo, "alice", "Deleted thread")
+	postAnswer(t, repo, deleted.ID, "bob", "goroutine goroutine goroutine")
+	must(t, repo.DeleteQuestion(ctx, deleted.ID.Hex(), "alice", ""))
+
+	top, err := repo.SearchQuestionsAnswersUsers(ctx, "goroutine", 1, 0)
+	must(t, err)
+	if top.Total != 2 || len(top.Hits) != 1 {
+		t.Errorf("search with hidden and deleted matches found %d hits of %d, want 1 of 2", len(top.Hits), top.Total)
+	}
+
+	empty, err := repo.SearchQuestionsAnswersUsers(ctx, `"-"`, 10, 0)
+	must(t, err)
+	if empty.Total != 0 {
+		t.Errorf("search for operators only found %d results", empty.Total)
+	}
+}
+
+func testAudit(t *testing.T, repo mongodb.Repository) {
+	for _, action := range []string{models.AuditTagAdd, models.AuditTagRemove, models.AuditTagAdd} {
+		must(t, repo.RecordAuditEvent(ctx, &models.AuditEvent{
+			ActorID:    "admin",
+			Action:     action,
+			TargetType: models.TargetTag,
+			TargetID:   "go",
+		}))
+	}
+
+	adds, err := repo.ListAuditEvents(ctx, models.AuditQuery{Action: models.AuditTagAdd}, models.Page{Limit: 1})
+	must(t, err)
+	if len(adds.Events) != 1 || adds.Next == nil {
+		t.Fatalf("first page = %+v, want one event and a cursor", adds)
+	}
+
+	rest, err := repo.ListAuditEvents(ctx, models.AuditQuery{Action: models.AuditTagAdd}, models.Page{Limit: 1, After: adds.Next})
+	must(t, err)
+	if len(rest.Events) != 1 || rest.Next != nil || rest.Events[0].ID == adds.Events[0].ID {
+		t.Errorf("second page = %+v, want the other event", rest)
+	}
+
+	future, err := repo.ListAuditEvents(ctx, models.AuditQuery{Since: time.Now().Add(time.Hour)}, models.Page{Limit: 10})
+	must(t, err)
+	if len(future.Events) != 0 {
+		t.Errorf("events from the future: %+v", future.Events)
+	}
+}
+
+func testPurge(t *testing.T, repo mongodb.Repository) {
+	gone := postQuestion(t, repo, "alice", "Purge me")
+	postAnswer(t, repo, gone.ID, "bob", "purged with the question")
+	kept := postQuestion(t, repo, "alice", "Keep me")
+	stale := postAnswer(t, repo, kept.ID, "bob", "purged on its own")
+	live := postAnswer(t, repo, kept.ID, "carol", "kept")
+
+	must(t, repo.DeleteQuestion(ctx, gone.ID.Hex(), "alice", ""))
+	must(t, repo.DeleteAnswer(ctx, kept.ID.Hex(), stale.ID.Hex(), "bob", ""))
+
+	purged, err := repo.PurgeDeleted(ctx, time.Now().Add(-time.Hour))
+	must(t, err)
+	if purged != 0 {
+		t.Errorf("purged %d posts deleted after the cutoff", purged)
+	}
+
+	purged, err = repo.PurgeDeleted(ctx, time.Now().Add(time.Second))
+	must(t, err)
+	if purged != 3 {
+		t.Errorf("purged %d posts, want 3", purged)
+	}
+
+	_, err = repo.GetDeletedQuestion(ctx, gone.ID.Hex())
+	wantKind(t, err, errs.NotFound)
+	_, err = repo.GetDeletedAnswer(ctx, kept.ID.Hex(), stale.ID.Hex())
+	wantKind(t, err, errs.NotFound)
+	getAnswer(t, repo, live)
+}
@@ -0,0 +1,166 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// EditQuestion keeps revision numbers distinct by having the update return
+// the previous document atomically.
+func (r *MongoRepository) EditQuestion(ctx context.Context, questionID string, revision *models.Revision) (*models.Question, error) {
+	qID, err := ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"question":       revision.Question,
+			"details":        revision.Details,
+			"tags":           revision.Tags,
+			"updated_at":     now,
+			"last_edited_by": revision.EditorID,
+		},
+		"$inc": bson.M{"revision_count": 1},
+	}
+
+	var previous models.Question
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+	err = r.questions.FindOneAndUpdate(ctx, bson.M{"_id": qID, "deleted_at": nil}, update, opts).Decode(&previous)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.NotFoundf("question not found")
+		}
+		return nil, dbError(err)
+	}
+
+	revision.TargetType = models.TargetQuestion
+	revision.TargetID = qID
+	revision.QuestionID = qID
+	revision.CreatedAt = now
+
+	original := &models.Revision{
+		TargetType: models.TargetQuestion,
+		TargetID:   qID,
+		QuestionID: qID,
+		EditorID:   previous.UserID,
+		Question:   previous.Question,
+		Details:    previous.Details,
+		Tags:       previous.Tags,
+		CreatedAt:  previous.CreatedAt,
+	}
+
+	if err := r.recordRevision(ctx, previous.RevisionCount, original, revision); err != nil {
+		return nil, err
+	}
+
+	edited := previous
+	edited.Question = revision.Question
+	edited.Details = revision.Details
+	edited.Tags = revision.Tags
+	edited.UpdatedAt = now
+	edited.LastEditedBy = revision.EditorID
+	edited.RevisionCount++
+	return &edited, nil
+}
+
+func (r *MongoRepository) EditAnswer(ctx context.Context, questionID, answerID string, revision *models.Revision) (*models.Answer, error) {
+	filter, err := answerFilter(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"answer":         revision.Answer,
+			"updated_at":     now,
+			"last_edited_by": revision.EditorID,
+		},
+		"$inc": bson.M{"revision_count": 1},
+	}
+
+	var previous models.Answer
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+	err = r.answers.FindOneAndUpdate(ctx, filter, update, opts).Decode(&previous)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.NotFoundf("answer not found")
+		}
+		return nil, dbError(err)
+	}
+
+	revision.TargetType = models.TargetAnswer
+	revision.TargetID = previous.ID
+	revision.QuestionID = previous.QuestionID
+	revision.CreatedAt = now
+
+	original := &models.Revision{
+		TargetType: models.TargetAnswer,
+		TargetID:   previous.ID,
+		QuestionID: previous.QuestionID,
+		EditorID:   previous.UserID,
+		Answer:     previous.Answer,
+		CreatedAt:  previous.CreatedAt,
+	}
+
+	if err := r.recordRevision(ctx, previous.RevisionCount, original, revision); err != nil {
+		return nil, err
+	}
+
+	edited := previous
+	edited.Answer = revision.Answer
+	edited.UpdatedAt = now
+	edited.LastEditedBy = revision.EditorID
+	edited.RevisionCount++
+	return &edited, nil
+}
+
+// recordRevision stores the revision produced by an edit. Posts are not
+// copied into revisions when created, so the first edit also stores the
+// original version as revision 1.
+func (r *MongoRepository) recordRevision(ctx context.Context, editsBefore int, original, revision *models.Revision) error {
+	documents := make([]interface{}, 0, 2)
+	if editsBefore == 0 {
+		original.ID = primitive.NewObjectID()
+		original.Revision = 1
+		documents = append(documents, original)
+	}
+
+	revision.ID = primitive.NewObjectID()
+	revision.Revision = editsBefore + 2
+	documents = append(documents, revision)
+
+	_, err := r.revisions.InsertMany(ctx, documents)
+	return dbError(err)
+}
+
+func (r *MongoRepository) GetRevisions(ctx context.Context, targetType, targetID string) ([]models.Revision, error) {
+	id, err := ParseID("target_id", targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "revision", Value: 1}})
+	cursor, err := r.revisions.Find(ctx, bson.M{"target_type": targetType, "target_id": id}, opts)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	revisions := []models.Revision{}
+	if err = cursor.All(ctx, &revisions); err != nil {
+		return nil, dbError(err)
+	}
+
+	return revisions, nil
+}
@@ -0,0 +1,202 @@
+package mongodb
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// SearchCandidateLimit bounds how many of the best matches each backend reads
+// from each collection or table before results are merged and ranked.
+const SearchCandidateLimit = 1000
+
+const (
+	// answerScoreWeight scales answer text scores relative to questions,
+	// whose index already weights titles above details.
+	answerScoreWeight = 2
+)
+
+// SearchQuestionsAnswersUsers reads candidates from the $text indexes. The
+// keyword is reduced to plain terms first so that callers cannot inject $text
+// operators.
+func (r *MongoRepository) SearchQuestionsAnswersUsers(ctx context.Context, keyword string, limit, offset int) (*models.SearchResult, error) {
+	terms := strings.Join(SearchTerms(keyword), " ")
+	if terms == "" {
+		return &models.SearchResult{}, nil
+	}
+
+	filter := bson.M{"$text": bson.M{"$search": terms}}
+	candidates := func(projection bson.M) *options.FindOptions {
+		projection["score"] = bson.M{"$meta": "textScore"}
+		return options.Find().
+			SetProjection(projection).
+			SetSort(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}}).
+			SetLimit(SearchCandidateLimit)
+	}
+
+	type scored struct {
+		questionID    primitive.ObjectID
+		questionScore float64
+		answerScore   float64
+		answer        *models.Answer
+	}
+	byQuestion := make(map[primitive.ObjectID]*scored)
+
+	questionCursor, err := r.questions.Find(ctx, withFilter(filter, bson.M{"deleted_at": nil, "is_hidden": bson.M{"$ne": true}}), candidates(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	var questionMatches []struct {
+		ID    primitive.ObjectID `bson:"_id"`
+		Score float64            `bson:"score"`
+	}
+	if err = questionCursor.All(ctx, &questionMatches); err != nil {
+		return nil, dbError(err)
+	}
+	for _, match := range questionMatches {
+		byQuestion[match.ID] = &scored{questionID: match.ID, questionScore: match.Score}
+	}
+
+	answerCursor, err := r.answers.Find(ctx, withFilter(filter, bson.M{"deleted_at": nil, "is_hidden": bson.M{"$ne": true}}), candidates(bson.M{"votes": 0, "flags": 0}))
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	var answerMatches []struct {
+		models.Answer `bson:",inline"`
+		Score         float64 `bson:"score"`
+	}
+	if err = answerCursor.All(ctx, &answerMatches); err != nil {
+		return nil, dbError(err)
+	}
+	for i := range answerMatches {
+		match := &answerMatches[i]
+		entry, ok := byQuestion[match.QuestionID]
+		if !ok {
+			entry = &scored{questionID: match.QuestionID}
+			byQuestion[match.QuestionID] = entry
+		}
+		// Only the best answer contributes so that a question cannot
+		// outrank others simply by having many mediocre answers.
+		if entry.answer == nil || match.Score > entry.answerScore {
+			entry.answer = &match.Answer
+			entry.answerScore = match.Score
+		}
+	}
+
+	// Answers of deleted or hidden questions must count towards neither the
+	// ranking nor the total, so drop the questions matched only through them
+	// that are not live and visible.
+	var answerOnly []primitive.ObjectID
+	for id, entry := range byQuestion {
+		if entry.questionScore == 0 {
+			answerOnly = append(answerOnly, id)
+		}
+	}
+	if len(answerOnly) > 0 {
+		visibleCursor, err := r.questions.Find(ctx, bson.M{"_id": bson.M{"$in": answerOnly}, "deleted_at": nil, "is_hidden": bson.M{"$ne": true}},
+			options.Find().SetProjection(bson.M{"_id": 1}))
+		if err != nil {
+			return nil, dbError(err)
+		}
+
+		var visible []struct {
+			ID primitive.ObjectID `bson:"_id"`
+		}
+		if err = visibleCursor.All(ctx, &visible); err != nil {
+			return nil, dbError(err)
+		}
+
+		live := make(map[primitive.ObjectID]bool, len(visible))
+		for _, question := range visible {
+			live[question.ID] = true
+		}
+		for _, id := range answerOnly {
+			if !live[id] {
+				delete(byQuestion, id)
+			}
+		}
+	}
+
+	ranked := make([]*scored, 0, len(byQuestion))
+	for _, entry := range byQuestion {
+		ranked = append(ranked, entry)
+	}
+	score := func(entry *scored) float64 {
+		return entry.questionScore + answerScoreWeight*entry.answerScore
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if si, sj := score(ranked[i]), score(ranked[j]); si != sj {
+			return si > sj
+		}
+		return ranked[i].questionID.Hex() > ranked[j].questionID.Hex()
+	})
+
+	result := &models.SearchResult{Total: int64(len(ranked))}
+	if offset >= len(ranked) {
+		return result, nil
+	}
+	ranked = ranked[offset:]
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+		result.Next = offset + limit
+	}
+
+	ids := make([]primitive.ObjectID, len(ranked))
+	for i, entry := range ranked {
+		ids[i] = entry.questionID
+	}
+
+	cursor, err := r.questions.Find(ctx, bson.M{"_id": bson.M{"$in": ids}, "deleted_at": nil, "is_hidden": bson.M{"$ne": true}})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var questions []models.Question
+	if err = cursor.All(ctx, &questions); err != nil {
+		return nil, dbError(err)
+	}
+
+	found := make(map[primitive.ObjectID]models.Question, len(questions))
+	for _, question := range questions {
+		found[question.ID] = question
+	}
+
+	// A question deleted or hidden since it was ranked is skipped
+	for _, entry := range ranked {
+		question, ok := found[entry.questionID]
+		if !ok {
+			continue
+		}
+		result.Hits = append(result.Hits, models.SearchHit{
+			Question: question,
+			Answer:   entry.answer,
+			Score:    score(entry),
+		})
+	}
+
+	return result, nil
+}
+
+// SearchTerms splits a search keyword into plain terms, dropping the
+// characters search engines treat as operators: phrase quotes and a leading
+// "-" for negation. Every backend reads the keyword through it, as does the
+// service when highlighting matches, so they all agree on the terms.
+func SearchTerms(keyword string) []string {
+	fields := strings.Fields(strings.ReplaceAll(keyword, `"`, " "))
+	terms := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field = strings.TrimLeft(field, "-"); field != "" {
+			terms = append(terms, field)
+		}
+	}
+	return terms
+}
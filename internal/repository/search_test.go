@@ -0,0 +1,25 @@
+package mongodb_test
+
+import (
+	"reflect"
+	"testing"
+
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+func TestSearchTerms(t *testing.T) {
+	tests := []struct {
+		keyword string
+		want    []string
+	}{
+		{"go channels", []string{"go", "channels"}},
+		{`"exact phrase" -excluded`, []string{"exact", "phrase", "excluded"}},
+		{"--- \" -", []string{}},
+		{"c++ a-b", []string{"c++", "a-b"}},
+	}
+	for _, tt := range tests {
+		if got := mongodb.SearchTerms(tt.keyword); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SearchTerms(%q) = %q, want %q", tt.keyword, got, tt.want)
+		}
+	}
+}
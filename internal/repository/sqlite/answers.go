@@ -0,0 +1,490 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+const answerColumns = `a.id, a.question_id, a.user_id, a.answer, a.upvotes, a.downvotes, a.is_flagged,
+	a.is_hidden, a.auto_hidden, a.comment_count, a.revision_count, a.last_edited_by, a.created_at,
+	a.updated_at, a.deleted_at, a.deleted_by, a.delete_reason, a.deleted_with_question`
+
+// Conditions selecting a single answer of a question by (answer ID,
+// question ID). Deleted answers are left out of liveAnswer.
+const (
+	liveAnswer = `a.id = ? AND a.question_id = ? AND a.deleted_at IS NULL`
+	anyAnswer  = `a.id = ? AND a.question_id = ?`
+)
+
+// answerIDs parses the IDs of an answer and its question.
+func answerIDs(questionID, answerID string) (primitive.ObjectID, primitive.ObjectID, error) {
+	qID, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return qID, primitive.NilObjectID, err
+	}
+
+	aID, err := mongodb.ParseID("answer_id", answerID)
+	return qID, aID, err
+}
+
+func scanAnswer(s scanner) (models.Answer, error) {
+	var (
+		a                    models.Answer
+		id, questionID       string
+		createdAt, updatedAt int64
+		deletedAt            sql.NullInt64
+	)
+	err := s.Scan(&id, &questionID, &a.UserID, &a.Answer, &a.Upvotes, &a.Downvotes, &a.IsFlagged,
+		&a.IsHidden, &a.AutoHidden, &a.CommentCount, &a.RevisionCount, &a.LastEditedBy, &createdAt,
+		&updatedAt, &deletedAt, &a.DeletedBy, &a.DeleteReason, &a.DeletedWithQuestion)
+	if err != nil {
+		return a, err
+	}
+
+	if a.ID, err = parseID(id); err != nil {
+		return a, err
+	}
+	if a.QuestionID, err = parseID(questionID); err != nil {
+		return a, err
+	}
+	a.CreatedAt = fromMillis(createdAt)
+	a.UpdatedAt = fromMillis(updatedAt)
+	a.DeletedAt = fromNullMillis(deletedAt)
+	return a, nil
+}
+
+// queryAnswers runs a query selecting answerColumns and loads the votes and
+// flags of the answers it returns.
+func queryAnswers(ctx context.Context, q querier, query string, args ...any) ([]models.Answer, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	answers := []models.Answer{}
+	for rows.Next() {
+		answer, err := scanAnswer(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		answers = append(answers, answer)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(answers) == 0 {
+		return answers, nil
+	}
+
+	ids := make([]string, len(answers))
+	for i := range answers {
+		ids[i] = answers[i].ID.Hex()
+	}
+
+	votes, err := loadVotes(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+	flags, err := loadFlags(ctx, q, models.TargetAnswer, ids, false)
+	if err != nil {
+		return nil, err
+	}
+	for i := range answers {
+		answers[i].Vote = votes[ids[i]]
+		answers[i].Flags = flags[ids[i]]
+	}
+	return answers, nil
+}
+
+// getAnswer returns the answer matching the conditions, if any.
+func getAnswer(ctx context.Context, q querier, where string, args ...any) (*models.Answer, bool, error) {
+	answers, err := queryAnswers(ctx, q, `SELECT `+answerColumns+` FROM answers a WHERE `+where, args...)
+	if err != nil || len(answers) == 0 {
+		return nil, false, err
+	}
+	return &answers[0], true, nil
+}
+
+// loadVotes returns the votes on each answer in the order they were cast.
+func loadVotes(ctx context.Context, q querier, answerIDs []string) (map[string][]models.Vote, error) {
+	in, args := placeholders(nil, answerIDs)
+	rows, err := q.QueryContext(ctx, `SELECT answer_id, user_id, vote_type, voted_at FROM votes
+		WHERE answer_id IN (`+in+`) ORDER BY seq`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	votes := make(map[string][]models.Vote, len(answerIDs))
+	for _, id := range answerIDs {
+		votes[id] = []models.Vote{}
+	}
+	for rows.Next() {
+		var (
+			answerID string
+			vote     models.Vote
+			votedAt  int64
+		)
+		if err := rows.Scan(&answerID, &vote.UserID, &vote.VoteType, &votedAt); err != nil {
+			return nil, err
+		}
+		vote.VotedAt = fromMillis(votedAt)
+		votes[answerID] = append(votes[answerID], vote)
+	}
+	return votes, rows.Err()
+}
+
+func insertVote(ctx context.Context, tx *sql.Tx, answerID string, vote models.Vote) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO votes (answer_id, user_id, vote_type, voted_at) VALUES (?, ?, ?, ?)`,
+		answerID, vote.UserID, vote.VoteType, millis(vote.VotedAt))
+	return err
+}
+
+func (r *Repository) GetAnswersByQuestionID(ctx context.Context, questionID string) ([]models.Answer, error) {
+	qID, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := queryAnswers(ctx, r.db, `SELECT `+answerColumns+` FROM answers a
+		WHERE a.question_id = ? AND a.deleted_at IS NULL AND a.is_hidden = 0
+		ORDER BY a.created_at, a.id`, qID.Hex())
+	return answers, dbError(err)
+}
+
+func (r *Repository) GetAnswerByID(ctx context.Context, questionID, answerID string) (*models.Answer, error) {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	answer, found, err := getAnswer(ctx, r.db, liveAnswer, aID.Hex(), qID.Hex())
+	if err != nil {
+		return nil, dbError(err)
+	}
+	if !found {
+		return nil, errs.NotFoundf("answer not found")
+	}
+	return answer, nil
+}
+
+func (r *Repository) GetAnswerOwnerID(ctx context.Context, questionID, answerID string) (string, error) {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return "", err
+	}
+
+	var userID string
+	err = r.db.QueryRowContext(ctx, `SELECT a.user_id FROM answers a WHERE `+liveAnswer, aID.Hex(), qID.Hex()).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", errs.NotFoundf("answer not found")
+	}
+	return userID, dbError(err)
+}
+
+// PostAnswer writes the answer and the counter in one transaction.
+func (r *Repository) PostAnswer(ctx context.Context, questionID string, answer *models.Answer) error {
+	qID, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return err
+	}
+
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		found, err := affected(tx.ExecContext(ctx, `UPDATE questions SET answer_count = answer_count + 1
+			WHERE id = ? AND deleted_at IS NULL`, qID.Hex()))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("question not found")
+		}
+
+		answer.ID = primitive.NewObjectID()
+		answer.QuestionID = qID
+		answer.CreatedAt = now()
+		if answer.Vote == nil {
+			answer.Vote = []models.Vote{}
+		}
+		if answer.Flags == nil {
+			answer.Flags = []models.Flag{}
+		}
+
+		id := answer.ID.Hex()
+		_, err = tx.ExecContext(ctx, `INSERT INTO answers (id, question_id, user_id, answer, upvotes, downvotes,
+			is_flagged, is_hidden, auto_hidden, comment_count, revision_count, last_edited_by, created_at,
+			updated_at, deleted_at, deleted_by, delete_reason, deleted_with_question)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			id, qID.Hex(), answer.UserID, answer.Answer, answer.Upvotes, answer.Downvotes,
+			answer.IsFlagged, answer.IsHidden, answer.AutoHidden, answer.CommentCount, answer.RevisionCount,
+			answer.LastEditedBy, millis(answer.CreatedAt), millis(answer.UpdatedAt), nullMillis(answer.DeletedAt),
+			answer.DeletedBy, answer.DeleteReason, answer.DeletedWithQuestion)
+		if err != nil {
+			return err
+		}
+
+		for _, vote := range answer.Vote {
+			if err := insertVote(ctx, tx, id, vote); err != nil {
+				return err
+			}
+		}
+		return insertFlags(ctx, tx, models.TargetAnswer, id, answer.Flags)
+	})
+}
+
+func (r *Repository) GetDeletedAnswer(ctx context.Context, questionID, answerID string) (*models.Answer, error) {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	answer, found, err := getAnswer(ctx, r.db, anyAnswer+` AND a.deleted_at IS NOT NULL`, aID.Hex(), qID.Hex())
+	if err != nil {
+		return nil, dbError(err)
+	}
+	if !found {
+		return nil, errs.NotFoundf("deleted answer not found")
+	}
+	return answer, nil
+}
+
+func (r *Repository) DeleteAnswer(ctx context.Context, questionID, answerID, deletedBy, reason string) error {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return err
+	}
+
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		found, err := affected(tx.ExecContext(ctx, `UPDATE answers AS a SET deleted_at = ?, deleted_by = ?, delete_reason = ?
+			WHERE `+liveAnswer, millis(now()), deletedBy, reason, aID.Hex(), qID.Hex()))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("answer not found")
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE questions SET answer_count = answer_count - 1 WHERE id = ?`, qID.Hex()); err != nil {
+			return err
+		}
+
+		// A deleted answer can no longer be the accepted one
+		_, err = tx.ExecContext(ctx, `UPDATE questions SET accepted_answer_id = NULL, is_answered = 0
+			WHERE id = ? AND accepted_answer_id = ?`, qID.Hex(), aID.Hex())
+		return err
+	})
+}
+
+func (r *Repository) RestoreAnswer(ctx context.Context, questionID, answerID string) (*models.Answer, error) {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var answer *models.Answer
+	err = r.tx(ctx, func(tx *sql.Tx) error {
+		found, err := affected(tx.ExecContext(ctx, `UPDATE answers AS a SET deleted_at = NULL, deleted_by = '', delete_reason = ''
+			WHERE `+anyAnswer+` AND a.deleted_at IS NOT NULL AND a.deleted_with_question = 0`, aID.Hex(), qID.Hex()))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("deleted answer not found")
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE questions SET answer_count = answer_count + 1 WHERE id = ?`, qID.Hex()); err != nil {
+			return err
+		}
+
+		answer, _, err = getAnswer(ctx, tx, anyAnswer, aID.Hex(), qID.Hex())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return answer, nil
+}
+
+func (r *Repository) FlagAnswer(ctx context.Context, questionID, answerID string, flag models.Flag, hide models.AutoHide) error {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return err
+	}
+
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		var exists bool
+		err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM answers a WHERE `+liveAnswer+`)`, aID.Hex(), qID.Hex()).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errs.NotFoundf("answer not found")
+		}
+
+		return addFlag(ctx, tx, postTables[models.TargetAnswer], aID.Hex(), flag, hide)
+	})
+}
+
+func (r *Repository) GetFlaggedAnswers(ctx context.Context, page models.Page) (*models.AnswerPage, error) {
+	const match = `a.is_flagged = 1 AND a.deleted_at IS NULL`
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM answers a WHERE `+match).Scan(&total); err != nil {
+		return nil, dbError(err)
+	}
+
+	clause, args := pageClause("a.created_at", "a.id", page, nil)
+	answers, err := queryAnswers(ctx, r.db, `SELECT `+answerColumns+` FROM answers a WHERE `+match+clause, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	result := answerPage(answers, page)
+	result.Total = total
+	return result, nil
+}
+
+func (r *Repository) HasUserVotedOnAnswer(ctx context.Context, questionID, answerID, userID string) (bool, string, error) {
+	answer, err := r.GetAnswerByID(ctx, questionID, answerID)
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, vote := range answer.Vote {
+		if vote.UserID == userID {
+			return true, vote.VoteType, nil
+		}
+	}
+
+	return false, "", nil
+}
+
+func (r *Repository) UpvoteAnswer(ctx context.Context, questionID, answerID, userID string) error {
+	return r.castVote(ctx, questionID, answerID, userID, models.VoteTypeUpvote)
+}
+
+func (r *Repository) DownvoteAnswer(ctx context.Context, questionID, answerID, userID string) error {
+	return r.castVote(ctx, questionID, answerID, userID, models.VoteTypeDownvote)
+}
+
+// castVote records a new vote or switches the user's existing vote of the
+// opposite type, adjusting the answer's counters in the same transaction.
+func (r *Repository) castVote(ctx context.Context, questionID, answerID, userID, voteType string) error {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return err
+	}
+
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		previous, err := currentVote(ctx, tx, qID, aID, userID)
+		if err != nil {
+			return err
+		}
+
+		switch previous {
+		case voteType:
+			return errs.AlreadyExistsf("already %sd", voteType)
+		case "":
+			err = insertVote(ctx, tx, aID.Hex(), models.Vote{UserID: userID, VoteType: voteType, VotedAt: now()})
+		default:
+			_, err = tx.ExecContext(ctx, `UPDATE votes SET vote_type = ?, voted_at = ? WHERE answer_id = ? AND user_id = ?`,
+				voteType, millis(now()), aID.Hex(), userID)
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE answers SET upvotes = upvotes + ?, downvotes = downvotes + ? WHERE id = ?`,
+			voteDelta(models.VoteTypeUpvote, voteType, previous), voteDelta(models.VoteTypeDownvote, voteType, previous), aID.Hex())
+		return err
+	})
+}
+
+// ToggleVote reads the user's current vote and applies the change in one
+// transaction.
+func (r *Repository) ToggleVote(ctx context.Context, questionID, answerID, userID, voteType string) (*models.Answer, error) {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var answer *models.Answer
+	err = r.tx(ctx, func(tx *sql.Tx) error {
+		previous, err := currentVote(ctx, tx, qID, aID, userID)
+		if err != nil {
+			return err
+		}
+
+		next := voteType
+		switch previous {
+		case voteType:
+			next = ""
+			_, err = tx.ExecContext(ctx, `DELETE FROM votes WHERE answer_id = ? AND user_id = ?`, aID.Hex(), userID)
+		case "":
+			err = insertVote(ctx, tx, aID.Hex(), models.Vote{UserID: userID, VoteType: voteType, VotedAt: now()})
+		default:
+			_, err = tx.ExecContext(ctx, `UPDATE votes SET vote_type = ?, voted_at = ? WHERE answer_id = ? AND user_id = ?`,
+				voteType, millis(now()), aID.Hex(), userID)
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE answers SET upvotes = upvotes + ?, downvotes = downvotes + ? WHERE id = ?`,
+			voteDelta(models.VoteTypeUpvote, next, previous), voteDelta(models.VoteTypeDownvote, next, previous), aID.Hex())
+		if err != nil {
+			return err
+		}
+
+		answer, _, err = getAnswer(ctx, tx, liveAnswer, aID.Hex(), qID.Hex())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return answer, nil
+}
+
+// currentVote returns the type of the user's vote on a live answer, or ""
+// if they have not voted on it.
+func currentVote(ctx context.Context, tx *sql.Tx, qID, aID primitive.ObjectID, userID string) (string, error) {
+	var exists bool
+	err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM answers a WHERE `+liveAnswer+`)`, aID.Hex(), qID.Hex()).Scan(&exists)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", errs.NotFoundf("answer not found")
+	}
+
+	var voteType string
+	err = tx.QueryRowContext(ctx, `SELECT vote_type FROM votes WHERE answer_id = ? AND user_id = ?`, aID.Hex(), userID).Scan(&voteType)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return voteType, err
+}
+
+// voteDelta is the change to the counter of counterType when a vote of
+// previous type, or none, becomes one of next type, or none.
+func voteDelta(counterType, next, previous string) int {
+	delta := 0
+	if next == counterType {
+		delta++
+	}
+	if previous == counterType {
+		delta--
+	}
+	return delta
+}
@@ -0,0 +1,96 @@
+package sqlite
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+const auditColumns = `id, actor_id, actor_role, action, target_type, target_id, reason, before, after,
+	method, peer_addr, user_agent, request_id, created_at`
+
+func (r *Repository) RecordAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	event.ID = primitive.NewObjectID()
+	event.CreatedAt = now()
+
+	var before, after any
+	if len(event.Before) > 0 {
+		before = []byte(event.Before)
+	}
+	if len(event.After) > 0 {
+		after = []byte(event.After)
+	}
+
+	_, err := r.db.ExecContext(ctx, `INSERT INTO audit_events (`+auditColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		event.ID.Hex(), event.ActorID, event.ActorRole, event.Action, event.TargetType, event.TargetID, event.Reason,
+		before, after, event.Request.Method, event.Request.PeerAddr, event.Request.UserAgent, event.Request.RequestID,
+		millis(event.CreatedAt))
+	return dbError(err)
+}
+
+func (r *Repository) ListAuditEvents(ctx context.Context, query models.AuditQuery, page models.Page) (*models.AuditPage, error) {
+	where := `1 = 1`
+	var args []any
+	for _, field := range []struct{ column, value string }{
+		{"actor_id", query.ActorID},
+		{"action", query.Action},
+		{"target_type", query.TargetType},
+		{"target_id", query.TargetID},
+	} {
+		if field.value != "" {
+			where += ` AND ` + field.column + ` = ?`
+			args = append(args, field.value)
+		}
+	}
+	if !query.Since.IsZero() {
+		where += ` AND created_at >= ?`
+		args = append(args, millis(query.Since))
+	}
+	if !query.Until.IsZero() {
+		where += ` AND created_at < ?`
+		args = append(args, millis(query.Until))
+	}
+
+	clause, args := pageClause("created_at", "id", page, args)
+	rows, err := r.db.QueryContext(ctx, `SELECT `+auditColumns+` FROM audit_events WHERE `+where+clause, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	var events []models.AuditEvent
+	for rows.Next() {
+		var (
+			event         models.AuditEvent
+			id            string
+			before, after []byte
+			createdAt     int64
+		)
+		err := rows.Scan(&id, &event.ActorID, &event.ActorRole, &event.Action, &event.TargetType, &event.TargetID,
+			&event.Reason, &before, &after, &event.Request.Method, &event.Request.PeerAddr, &event.Request.UserAgent,
+			&event.Request.RequestID, &createdAt)
+		if err != nil {
+			return nil, dbError(err)
+		}
+
+		if event.ID, err = parseID(id); err != nil {
+			return nil, dbError(err)
+		}
+		if len(before) > 0 {
+			event.Before = bson.Raw(before)
+		}
+		if len(after) > 0 {
+			event.After = bson.Raw(after)
+		}
+		event.CreatedAt = fromMillis(createdAt)
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dbError(err)
+	}
+
+	return auditPage(events, page), nil
+}
@@ -0,0 +1,308 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+const commentColumns = `c.id, c.target_type, c.target_id, c.question_id, c.parent_id, c.user_id, c.body,
+	c.is_flagged, c.is_hidden, c.auto_hidden, c.created_at, c.updated_at, c.deleted_at`
+
+func scanComment(s scanner) (models.Comment, error) {
+	var (
+		c                        models.Comment
+		id, targetID, questionID string
+		parentID                 sql.NullString
+		createdAt, updatedAt     int64
+		deletedAt                sql.NullInt64
+	)
+	err := s.Scan(&id, &c.TargetType, &targetID, &questionID, &parentID, &c.UserID, &c.Body,
+		&c.IsFlagged, &c.IsHidden, &c.AutoHidden, &createdAt, &updatedAt, &deletedAt)
+	if err != nil {
+		return c, err
+	}
+
+	if c.ID, err = parseID(id); err != nil {
+		return c, err
+	}
+	if c.TargetID, err = parseID(targetID); err != nil {
+		return c, err
+	}
+	if c.QuestionID, err = parseID(questionID); err != nil {
+		return c, err
+	}
+	if parentID.Valid {
+		parent, err := parseID(parentID.String)
+		if err != nil {
+			return c, err
+		}
+		c.ParentID = &parent
+	}
+	c.CreatedAt = fromMillis(createdAt)
+	c.UpdatedAt = fromMillis(updatedAt)
+	c.DeletedAt = fromNullMillis(deletedAt)
+	return c, nil
+}
+
+// queryComments runs a query selecting commentColumns and loads the
+// ancestors and flags of the comments it returns.
+func queryComments(ctx context.Context, q querier, query string, args ...any) ([]models.Comment, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []models.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(comments) == 0 {
+		return comments, nil
+	}
+
+	ids := make([]string, len(comments))
+	for i := range comments {
+		ids[i] = comments[i].ID.Hex()
+	}
+
+	ancestors, err := loadAncestors(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+	flags, err := loadFlags(ctx, q, models.TargetComment, ids, false)
+	if err != nil {
+		return nil, err
+	}
+	for i := range comments {
+		comments[i].Ancestors = ancestors[ids[i]]
+		comments[i].Flags = flags[ids[i]]
+	}
+	return comments, nil
+}
+
+// loadAncestors returns the ancestors of each comment, root first.
+func loadAncestors(ctx context.Context, q querier, commentIDs []string) (map[string][]primitive.ObjectID, error) {
+	in, args := placeholders(nil, commentIDs)
+	rows, err := q.QueryContext(ctx, `SELECT comment_id, ancestor_id FROM comment_ancestors
+		WHERE comment_id IN (`+in+`) ORDER BY comment_id, depth`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ancestors := make(map[string][]primitive.ObjectID, len(commentIDs))
+	for _, id := range commentIDs {
+		ancestors[id] = []primitive.ObjectID{}
+	}
+	for rows.Next() {
+		var commentID, ancestorID string
+		if err := rows.Scan(&commentID, &ancestorID); err != nil {
+			return nil, err
+		}
+		id, err := parseID(ancestorID)
+		if err != nil {
+			return nil, err
+		}
+		ancestors[commentID] = append(ancestors[commentID], id)
+	}
+	return ancestors, rows.Err()
+}
+
+// getComment returns a comment unless it is missing or deleted.
+func getComment(ctx context.Context, q querier, id string) (*models.Comment, error) {
+	comments, err := queryComments(ctx, q, `SELECT `+commentColumns+` FROM comments c
+		WHERE c.id = ? AND c.deleted_at IS NULL`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(comments) == 0 {
+		return nil, errs.NotFoundf("comment not found")
+	}
+	return &comments[0], nil
+}
+
+// adjustCommentCount changes the comment_count of the live question or
+// answer a comment belongs to and reports whether there is one.
+func adjustCommentCount(ctx context.Context, tx *sql.Tx, comment *models.Comment, delta int64) (bool, error) {
+	if comment.TargetType == models.TargetAnswer {
+		return affected(tx.ExecContext(ctx, `UPDATE answers SET comment_count = comment_count + ?
+			WHERE id = ? AND question_id = ? AND deleted_at IS NULL`, delta, comment.TargetID.Hex(), comment.QuestionID.Hex()))
+	}
+	return affected(tx.ExecContext(ctx, `UPDATE questions SET comment_count = comment_count + ?
+		WHERE id = ? AND deleted_at IS NULL`, delta, comment.QuestionID.Hex()))
+}
+
+func (r *Repository) PostComment(ctx context.Context, comment *models.Comment) error {
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		comment.Ancestors = []primitive.ObjectID{}
+		if comment.ParentID != nil {
+			parent, err := getComment(ctx, tx, comment.ParentID.Hex())
+			if err != nil {
+				return err
+			}
+
+			if parent.TargetType != comment.TargetType || parent.TargetID != comment.TargetID {
+				return errs.InvalidField("parent_id", "parent comment belongs to a different post")
+			}
+
+			comment.Ancestors = append(parent.Ancestors, parent.ID)
+		}
+
+		found, err := adjustCommentCount(ctx, tx, comment, 1)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("%s not found", comment.TargetType)
+		}
+
+		comment.ID = primitive.NewObjectID()
+		comment.CreatedAt = now()
+		comment.UpdatedAt = comment.CreatedAt
+		if comment.Flags == nil {
+			comment.Flags = []models.Flag{}
+		}
+
+		var parentID any
+		if comment.ParentID != nil {
+			parentID = comment.ParentID.Hex()
+		}
+
+		id := comment.ID.Hex()
+		_, err = tx.ExecContext(ctx, `INSERT INTO comments (id, target_type, target_id, question_id, parent_id, user_id,
+			body, is_flagged, is_hidden, auto_hidden, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			id, comment.TargetType, comment.TargetID.Hex(), comment.QuestionID.Hex(), parentID, comment.UserID,
+			comment.Body, comment.IsFlagged, comment.IsHidden, comment.AutoHidden, millis(comment.CreatedAt), millis(comment.UpdatedAt))
+		if err != nil {
+			return err
+		}
+
+		for depth, ancestor := range comment.Ancestors {
+			_, err := tx.ExecContext(ctx, `INSERT INTO comment_ancestors (comment_id, depth, ancestor_id) VALUES (?, ?, ?)`,
+				id, depth, ancestor.Hex())
+			if err != nil {
+				return err
+			}
+		}
+		return insertFlags(ctx, tx, models.TargetComment, id, comment.Flags)
+	})
+}
+
+func (r *Repository) GetCommentByID(ctx context.Context, commentID string) (*models.Comment, error) {
+	id, err := mongodb.ParseID("comment_id", commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := getComment(ctx, r.db, id.Hex())
+	return comment, dbError(err)
+}
+
+func (r *Repository) EditComment(ctx context.Context, commentID, body string) (*models.Comment, error) {
+	id, err := mongodb.ParseID("comment_id", commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment *models.Comment
+	err = r.tx(ctx, func(tx *sql.Tx) error {
+		found, err := affected(tx.ExecContext(ctx, `UPDATE comments SET body = ?, updated_at = ?
+			WHERE id = ? AND deleted_at IS NULL`, body, millis(now()), id.Hex()))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("comment not found")
+		}
+
+		comment, err = getComment(ctx, tx, id.Hex())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+func (r *Repository) DeleteComment(ctx context.Context, commentID string) error {
+	id, err := mongodb.ParseID("comment_id", commentID)
+	if err != nil {
+		return err
+	}
+
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		comment, err := getComment(ctx, tx, id.Hex())
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE comments SET body = ?, deleted_at = ? WHERE id = ?`,
+			models.DeletedCommentBody, millis(now()), id.Hex())
+		if err != nil {
+			return err
+		}
+
+		_, err = adjustCommentCount(ctx, tx, comment, -1)
+		return err
+	})
+}
+
+func (r *Repository) ListComments(ctx context.Context, targetType, targetID string, page models.Page) (*models.CommentPage, error) {
+	id, err := mongodb.ParseID("target_id", targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if targetType != models.TargetQuestion && targetType != models.TargetAnswer {
+		return nil, errs.InvalidField("target_type", "must be question or answer")
+	}
+
+	table := postTables[targetType]
+	var live bool
+	err = r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM `+table.name+` WHERE id = ? AND `+table.live+`)`, id.Hex()).Scan(&live)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	if !live {
+		return nil, errs.NotFoundf("%s not found", targetType)
+	}
+
+	clause, args := pageClause("c.created_at", "c.id", page, []any{targetType, id.Hex()})
+	comments, err := queryComments(ctx, r.db, `SELECT `+commentColumns+` FROM comments c
+		WHERE c.target_type = ? AND c.target_id = ? AND c.is_hidden = 0`+clause, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return commentPage(comments, page), nil
+}
+
+func (r *Repository) FlagComment(ctx context.Context, commentID string, flag models.Flag, hide models.AutoHide) error {
+	id, err := mongodb.ParseID("comment_id", commentID)
+	if err != nil {
+		return err
+	}
+
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		return addFlag(ctx, tx, postTables[models.TargetComment], id.Hex(), flag, hide)
+	})
+}
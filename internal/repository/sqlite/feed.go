@@ -0,0 +1,143 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+const (
+	// feedWindow is how far back the feed looks for questions that match
+	// none of the user's follows.
+	feedWindow = 30 * 24 * time.Hour
+
+	// Feed score weights, the same as the MongoDB repository's.
+	feedTagWeight        = 3.0
+	feedAuthorWeight     = 5.0
+	feedUnansweredWeight = 1.0
+	feedRecencyWeight    = 4.0
+)
+
+func (r *Repository) Follow(ctx context.Context, follow *models.Follow) error {
+	follow.ID = primitive.NewObjectID()
+	follow.CreatedAt = now()
+
+	_, err := r.db.ExecContext(ctx, `INSERT INTO follows (id, user_id, target_type, target, created_at) VALUES (?, ?, ?, ?, ?)`,
+		follow.ID.Hex(), follow.UserID, follow.TargetType, follow.Target, millis(follow.CreatedAt))
+	if isUniqueViolation(err) {
+		return errs.AlreadyExistsf("already following %s", follow.TargetType)
+	}
+	return dbError(err)
+}
+
+func (r *Repository) Unfollow(ctx context.Context, userID, targetType, target string) error {
+	found, err := affected(r.db.ExecContext(ctx, `DELETE FROM follows WHERE user_id = ? AND target_type = ? AND target = ?`,
+		userID, targetType, target))
+	if err != nil {
+		return dbError(err)
+	}
+	if !found {
+		return errs.NotFoundf("not following %s", targetType)
+	}
+	return nil
+}
+
+func (r *Repository) ListFollows(ctx context.Context, userID, targetType string, page models.Page) (*models.FollowPage, error) {
+	where, args := `user_id = ?`, []any{userID}
+	if targetType != "" {
+		where += ` AND target_type = ?`
+		args = append(args, targetType)
+	}
+
+	clause, args := pageClause("created_at", "id", page, args)
+	rows, err := r.db.QueryContext(ctx, `SELECT id, user_id, target_type, target, created_at FROM follows WHERE `+where+clause, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	var follows []models.Follow
+	for rows.Next() {
+		var (
+			follow    models.Follow
+			id        string
+			createdAt int64
+		)
+		if err := rows.Scan(&id, &follow.UserID, &follow.TargetType, &follow.Target, &createdAt); err != nil {
+			return nil, dbError(err)
+		}
+		if follow.ID, err = parseID(id); err != nil {
+			return nil, dbError(err)
+		}
+		follow.CreatedAt = fromMillis(createdAt)
+		follows = append(follows, follow)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dbError(err)
+	}
+
+	return followPage(follows, page), nil
+}
+
+// GetUserFeed only considers questions matching no follow within feedWindow.
+func (r *Repository) GetUserFeed(ctx context.Context, query models.FeedQuery) (*models.FeedPage, error) {
+	const (
+		followedTags  = `SELECT target FROM follows WHERE user_id = @user AND target_type = 'tag'`
+		followedUsers = `SELECT target FROM follows WHERE user_id = @user AND target_type = 'user'`
+	)
+
+	args := []any{
+		sql.Named("user", query.UserID),
+		sql.Named("as_of", millis(query.AsOf)),
+		sql.Named("window_start", millis(query.AsOf.Add(-feedWindow))),
+		sql.Named("limit", query.Limit+1),
+		sql.Named("offset", query.Offset),
+	}
+
+	filters := ""
+	if len(query.Tags) > 0 {
+		in := ""
+		for i, tag := range query.Tags {
+			name := fmt.Sprintf("tag%d", i)
+			if i > 0 {
+				in += ", "
+			}
+			in += "@" + name
+			args = append(args, sql.Named(name, tag))
+		}
+		filters += ` AND q.id IN (SELECT question_id FROM question_tags WHERE tag IN (` + in + `))`
+	}
+	if query.UnansweredOnly {
+		filters += ` AND q.is_answered = 0`
+	}
+
+	score := fmt.Sprintf(`%v * (SELECT COUNT(DISTINCT tag) FROM question_tags WHERE question_id = q.id AND tag IN (%s))
+		+ CASE WHEN q.user_id IN (%s) THEN %v ELSE 0 END
+		+ CASE WHEN q.is_answered THEN 0 ELSE %v END
+		+ %v / (1 + (@as_of - q.created_at) / %d.0)`,
+		feedTagWeight, followedTags, followedUsers, feedAuthorWeight, feedUnansweredWeight,
+		feedRecencyWeight, (24 * time.Hour).Milliseconds())
+
+	questions, err := queryQuestions(ctx, r.db, `SELECT `+questionColumns+` FROM questions q
+		WHERE q.created_at <= @as_of AND q.user_id <> @user AND q.deleted_at IS NULL AND q.is_hidden = 0
+		AND (q.id IN (SELECT question_id FROM question_tags WHERE tag IN (`+followedTags+`))
+			OR q.user_id IN (`+followedUsers+`)
+			OR q.created_at >= @window_start)`+filters+`
+		ORDER BY `+score+` DESC, q.created_at DESC, q.id DESC
+		LIMIT @limit OFFSET @offset`, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	if len(questions) > query.Limit {
+		return &models.FeedPage{Questions: questions[:query.Limit], HasMore: true}, nil
+	}
+
+	return &models.FeedPage{Questions: questions}, nil
+}
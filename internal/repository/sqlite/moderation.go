@@ -0,0 +1,381 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+// postTable describes the table holding one kind of post that can be
+// flagged.
+type postTable struct {
+	targetType string
+	name       string
+	// body and questionID are the columns that hold the post's text and
+	// the ID of the question it belongs to.
+	body       string
+	questionID string
+	// live selects the posts that have not been deleted.
+	live string
+}
+
+// moderationTargets lists the kinds of post that can be flagged, in the
+// order the moderation queue merges them.
+var moderationTargets = []string{models.TargetQuestion, models.TargetAnswer, models.TargetComment}
+
+var postTables = map[string]postTable{
+	models.TargetQuestion: {models.TargetQuestion, "questions", "question", "id", "deleted_at IS NULL"},
+	models.TargetAnswer:   {models.TargetAnswer, "answers", "answer", "question_id", "deleted_at IS NULL"},
+	models.TargetComment:  {models.TargetComment, "comments", "body", "question_id", "deleted_at IS NULL"},
+}
+
+// moderationTable returns the table holding posts of targetType.
+func moderationTable(targetType string) (postTable, error) {
+	table, ok := postTables[targetType]
+	if !ok {
+		return table, errs.InvalidField("target_type", "must be question, answer or comment")
+	}
+	return table, nil
+}
+
+const flagColumns = `target_id, user_id, reason, details, weight, created_at, resolution, resolved_by, resolved_at, resolution_note`
+
+// loadFlags returns the flags on each post of targetType in the order they
+// were raised. With openOnly set, resolved flags are left out.
+func loadFlags(ctx context.Context, q querier, targetType string, ids []string, openOnly bool) (map[string][]models.Flag, error) {
+	in, args := placeholders([]any{targetType}, ids)
+	query := `SELECT ` + flagColumns + ` FROM flags WHERE target_type = ? AND target_id IN (` + in + `)`
+	if openOnly {
+		query += ` AND resolved_at IS NULL`
+	}
+
+	rows, err := q.QueryContext(ctx, query+` ORDER BY id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	flags := make(map[string][]models.Flag, len(ids))
+	for _, id := range ids {
+		flags[id] = []models.Flag{}
+	}
+	for rows.Next() {
+		var (
+			targetID   string
+			flag       models.Flag
+			createdAt  int64
+			resolvedAt sql.NullInt64
+		)
+		err := rows.Scan(&targetID, &flag.UserID, &flag.Reason, &flag.Details, &flag.Weight, &createdAt,
+			&flag.Resolution, &flag.ResolvedBy, &resolvedAt, &flag.ResolutionNote)
+		if err != nil {
+			return nil, err
+		}
+		flag.CreatedAt = fromMillis(createdAt)
+		flag.ResolvedAt = fromNullMillis(resolvedAt)
+		flags[targetID] = append(flags[targetID], flag)
+	}
+	return flags, rows.Err()
+}
+
+func insertFlags(ctx context.Context, tx *sql.Tx, targetType, targetID string, flags []models.Flag) error {
+	for _, flag := range flags {
+		_, err := tx.ExecContext(ctx, `INSERT INTO flags (target_type, `+flagColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			targetType, targetID, flag.UserID, flag.Reason, flag.Details, flag.Weight, millis(flag.CreatedAt),
+			flag.Resolution, flag.ResolvedBy, nullMillis(flag.ResolvedAt), flag.ResolutionNote)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addFlag adds flag to a post unless its user already has an open flag
+// there, and hides the post if that takes it over hide's limits. It runs
+// in the caller's transaction, so concurrent flags are counted exactly
+// once.
+func addFlag(ctx context.Context, tx *sql.Tx, table postTable, id string, flag models.Flag, hide models.AutoHide) error {
+	var exists bool
+	err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM `+table.name+` WHERE id = ? AND `+table.live+`)`, id).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errs.NotFoundf("%s not found", table.targetType)
+	}
+
+	flag.CreatedAt = now()
+	flag.Resolution, flag.ResolvedBy, flag.ResolvedAt, flag.ResolutionNote = "", "", nil, ""
+	if err := insertFlags(ctx, tx, table.targetType, id, []models.Flag{flag}); err != nil {
+		if isUniqueViolation(err) {
+			return errs.AlreadyExistsf("you have already flagged this %s", table.targetType)
+		}
+		return err
+	}
+
+	// Flags without a weight count as 1 towards the score
+	var users int
+	var score float64
+	err = tx.QueryRowContext(ctx, `SELECT COUNT(DISTINCT user_id), COALESCE(SUM(CASE WHEN weight = 0 THEN 1 ELSE weight END), 0)
+		FROM flags WHERE target_type = ? AND target_id = ? AND resolved_at IS NULL`, table.targetType, id).Scan(&users, &score)
+	if err != nil {
+		return err
+	}
+	overLimit := (hide.Flags > 0 && users >= hide.Flags) || (hide.Score > 0 && score >= hide.Score)
+
+	// Posts a moderator already hid stay hidden without becoming
+	// auto-hidden, so that dismissing later flags does not reveal them.
+	_, err = tx.ExecContext(ctx, `UPDATE `+table.name+` SET is_flagged = 1,
+		auto_hidden = auto_hidden OR (is_hidden = 0 AND ?), is_hidden = is_hidden OR ?
+		WHERE id = ?`, overLimit, overLimit, id)
+	return err
+}
+
+// moderationQuery selects the posts of table with open flags as
+// ModerationItem columns, with flagged_at the time of the most recent one.
+// A non-empty reason keeps only posts with an open flag for that reason.
+func moderationQuery(table postTable, reason string, args []any) (string, []any) {
+	query := fmt.Sprintf(`SELECT '%[1]s' AS target_type, p.id AS target_id, p.%[3]s AS question_id, p.user_id,
+		p.%[2]s AS body, p.is_hidden, p.auto_hidden, MAX(f.created_at) AS flagged_at
+		FROM %[4]s p JOIN flags f ON f.target_type = '%[1]s' AND f.target_id = p.id AND f.resolved_at IS NULL
+		WHERE p.is_flagged = 1 AND %[5]s
+		GROUP BY p.id`, table.targetType, table.body, table.questionID, table.name, table.live)
+	if reason != "" {
+		query += ` HAVING SUM(f.reason = ?) > 0`
+		args = append(args, reason)
+	}
+	return query, args
+}
+
+func scanModerationItem(s scanner) (models.ModerationItem, error) {
+	var (
+		item                 models.ModerationItem
+		targetID, questionID string
+		flaggedAt            sql.NullInt64
+	)
+	err := s.Scan(&item.TargetType, &targetID, &questionID, &item.UserID, &item.Body, &item.IsHidden, &item.AutoHidden, &flaggedAt)
+	if err != nil {
+		return item, err
+	}
+
+	if item.TargetID, err = parseID(targetID); err != nil {
+		return item, err
+	}
+	if item.QuestionID, err = parseID(questionID); err != nil {
+		return item, err
+	}
+	if flaggedAt.Valid {
+		item.FlaggedAt = fromMillis(flaggedAt.Int64)
+	}
+	return item, nil
+}
+
+// loadOpenFlags fills in the open flags of each item.
+func loadOpenFlags(ctx context.Context, q querier, items []models.ModerationItem) error {
+	ids := make(map[string][]string)
+	for _, item := range items {
+		ids[item.TargetType] = append(ids[item.TargetType], item.TargetID.Hex())
+	}
+
+	flags := make(map[string]map[string][]models.Flag, len(ids))
+	for targetType, targetIDs := range ids {
+		var err error
+		if flags[targetType], err = loadFlags(ctx, q, targetType, targetIDs, true); err != nil {
+			return err
+		}
+	}
+
+	for i := range items {
+		items[i].Flags = flags[items[i].TargetType][items[i].TargetID.Hex()]
+	}
+	return nil
+}
+
+func (r *Repository) ListModerationQueue(ctx context.Context, targetType, reason string, page models.Page) (*models.ModerationPage, error) {
+	targets := moderationTargets
+	if targetType != "" {
+		targets = []string{targetType}
+	}
+
+	var queries []string
+	var args []any
+	for _, target := range targets {
+		table, err := moderationTable(target)
+		if err != nil {
+			return nil, err
+		}
+
+		var query string
+		query, args = moderationQuery(table, reason, args)
+		queries = append(queries, query)
+	}
+
+	clause, args := pageClause("flagged_at", "target_id", page, args)
+	rows, err := r.db.QueryContext(ctx, `SELECT * FROM (`+strings.Join(queries, ` UNION ALL `)+`) WHERE 1 = 1`+clause, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	var items []models.ModerationItem
+	for rows.Next() {
+		item, err := scanModerationItem(rows)
+		if err != nil {
+			rows.Close()
+			return nil, dbError(err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, dbError(err)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dbError(err)
+	}
+
+	if err := loadOpenFlags(ctx, r.db, items); err != nil {
+		return nil, dbError(err)
+	}
+	return moderationPage(items, page), nil
+}
+
+func (r *Repository) CountFlagsByReason(ctx context.Context, query models.FlagCountQuery) (map[string]int64, error) {
+	where := `1 = 1`
+	var args []any
+	if query.TargetType != "" {
+		if _, err := moderationTable(query.TargetType); err != nil {
+			return nil, err
+		}
+		where += ` AND target_type = ?`
+		args = append(args, query.TargetType)
+	}
+	if !query.Since.IsZero() {
+		where += ` AND created_at >= ?`
+		args = append(args, millis(query.Since))
+	}
+	if !query.IncludeResolved {
+		where += ` AND resolved_at IS NULL`
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT reason, COUNT(*) FROM flags WHERE `+where+` GROUP BY reason`, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int64)
+	for rows.Next() {
+		var reason string
+		var count int64
+		if err := rows.Scan(&reason, &count); err != nil {
+			return nil, dbError(err)
+		}
+		counts[reason] = count
+	}
+	return counts, dbError(rows.Err())
+}
+
+func (r *Repository) GetModerationItem(ctx context.Context, targetType, targetID string) (*models.ModerationItem, error) {
+	table, err := moderationTable(targetType)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := mongodb.ParseID("target_id", targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := scanModerationItem(r.db.QueryRowContext(ctx, fmt.Sprintf(`SELECT '%[1]s', p.id, p.%[3]s, p.user_id, p.%[2]s,
+		p.is_hidden, p.auto_hidden, (SELECT MAX(created_at) FROM flags WHERE target_type = '%[1]s' AND target_id = p.id AND resolved_at IS NULL)
+		FROM %[4]s p WHERE p.id = ? AND %[5]s`, table.targetType, table.body, table.questionID, table.name, table.live), id.Hex()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.NotFoundf("%s not found", targetType)
+	}
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	items := []models.ModerationItem{item}
+	if err := loadOpenFlags(ctx, r.db, items); err != nil {
+		return nil, dbError(err)
+	}
+	return &items[0], nil
+}
+
+func (r *Repository) ResolveFlags(ctx context.Context, targetType, targetID string, resolution models.FlagResolution) error {
+	table, err := moderationTable(targetType)
+	if err != nil {
+		return err
+	}
+
+	id, err := mongodb.ParseID("target_id", targetID)
+	if err != nil {
+		return err
+	}
+
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		found, err := affected(tx.ExecContext(ctx, `UPDATE flags SET resolution = ?, resolved_by = ?, resolved_at = ?, resolution_note = ?
+			WHERE target_type = ? AND target_id = ? AND resolved_at IS NULL
+			AND EXISTS (SELECT 1 FROM `+table.name+` WHERE id = ? AND `+table.live+`)`,
+			resolution.Resolution, resolution.ModeratorID, millis(now()), resolution.Note, targetType, id.Hex(), id.Hex()))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("%s has no open flags", targetType)
+		}
+
+		update := `is_flagged = 0, is_hidden = CASE WHEN auto_hidden = 1 THEN 0 ELSE is_hidden END, auto_hidden = 0`
+		if resolution.Resolution == models.FlagHidden {
+			update = `is_flagged = 0, is_hidden = 1, auto_hidden = 0`
+		}
+		_, err = tx.ExecContext(ctx, `UPDATE `+table.name+` SET `+update+` WHERE id = ?`, id.Hex())
+		return err
+	})
+}
+
+func (r *Repository) ReopenFlags(ctx context.Context, targetType, targetID string, resolution models.FlagResolution) error {
+	table, err := moderationTable(targetType)
+	if err != nil {
+		return err
+	}
+
+	id, err := mongodb.ParseID("target_id", targetID)
+	if err != nil {
+		return err
+	}
+
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		found, err := affected(tx.ExecContext(ctx, `UPDATE flags SET resolution = '', resolved_by = '', resolved_at = NULL, resolution_note = ''
+			WHERE target_type = ? AND target_id = ? AND resolved_at IS NOT NULL
+			AND resolution = ? AND resolved_by = ? AND resolution_note = ?`,
+			targetType, id.Hex(), resolution.Resolution, resolution.ModeratorID, resolution.Note))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("%s has no flags resolved that way", targetType)
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE `+table.name+` SET is_flagged = 1 WHERE id = ?`, id.Hex())
+		return err
+	})
+}
+
+func (r *Repository) AddWarning(ctx context.Context, warning *models.Warning) error {
+	warning.ID = primitive.NewObjectID()
+	warning.CreatedAt = now()
+
+	_, err := r.db.ExecContext(ctx, `INSERT INTO warnings (id, user_id, moderator_id, target_type, target_id, reason, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`, warning.ID.Hex(), warning.UserID, warning.ModeratorID, warning.TargetType,
+		warning.TargetID.Hex(), warning.Reason, millis(warning.CreatedAt))
+	return dbError(err)
+}
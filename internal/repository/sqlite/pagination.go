@@ -0,0 +1,81 @@
+package sqlite
+
+import (
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// pageClause continues a WHERE clause with the page cursor condition, the
+// (column, idColumn) newest-first order shared by every paginated listing
+// and a limit of one row more than the page holds, which tells us whether
+// there is a next page without a second query.
+func pageClause(column, idColumn string, page models.Page, args []any) (string, []any) {
+	clause := ""
+	if page.After != nil {
+		after := millis(page.After.CreatedAt)
+		clause = ` AND (` + column + ` < ? OR (` + column + ` = ? AND ` + idColumn + ` < ?))`
+		args = append(args, after, after, page.After.ID.Hex())
+	}
+
+	clause += ` ORDER BY ` + column + ` DESC, ` + idColumn + ` DESC LIMIT ?`
+	return clause, append(args, page.Limit+1)
+}
+
+// trimPage cuts the extra row fetched by pageClause and returns the cursor
+// of the last kept item when there are more results.
+func trimPage(count int, page models.Page, cursorAt func(i int) models.Cursor) (int, *models.Cursor) {
+	if count <= page.Limit {
+		return count, nil
+	}
+
+	next := cursorAt(page.Limit - 1)
+	return page.Limit, &next
+}
+
+func questionPage(questions []models.Question, page models.Page) *models.QuestionPage {
+	n, next := trimPage(len(questions), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: questions[i].CreatedAt, ID: questions[i].ID}
+	})
+	return &models.QuestionPage{Questions: questions[:n], Next: next}
+}
+
+func answerPage(answers []models.Answer, page models.Page) *models.AnswerPage {
+	n, next := trimPage(len(answers), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: answers[i].CreatedAt, ID: answers[i].ID}
+	})
+	return &models.AnswerPage{Answers: answers[:n], Next: next}
+}
+
+func commentPage(comments []models.Comment, page models.Page) *models.CommentPage {
+	n, next := trimPage(len(comments), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: comments[i].CreatedAt, ID: comments[i].ID}
+	})
+	return &models.CommentPage{Comments: comments[:n], Next: next}
+}
+
+func tagPage(tags []models.Tag, page models.Page) *models.TagPage {
+	n, next := trimPage(len(tags), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: tags[i].CreatedAt, ID: tags[i].ID}
+	})
+	return &models.TagPage{Tags: tags[:n], Next: next}
+}
+
+func followPage(follows []models.Follow, page models.Page) *models.FollowPage {
+	n, next := trimPage(len(follows), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: follows[i].CreatedAt, ID: follows[i].ID}
+	})
+	return &models.FollowPage{Follows: follows[:n], Next: next}
+}
+
+func moderationPage(items []models.ModerationItem, page models.Page) *models.ModerationPage {
+	n, next := trimPage(len(items), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: items[i].FlaggedAt, ID: items[i].TargetID}
+	})
+	return &models.ModerationPage{Items: items[:n], Next: next}
+}
+
+func auditPage(events []models.AuditEvent, page models.Page) *models.AuditPage {
+	n, next := trimPage(len(events), page, func(i int) models.Cursor {
+		return models.Cursor{CreatedAt: events[i].CreatedAt, ID: events[i].ID}
+	})
+	return &models.AuditPage{Events: events[:n], Next: next}
+}
@@ -0,0 +1,100 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+// PurgeDeleted leaves the tags, votes and flags of the removed posts to
+// foreign keys and triggers.
+func (r *Repository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	err := r.tx(ctx, func(tx *sql.Tx) error {
+		purged = 0
+
+		questionIDs, err := tombstoneIDs(ctx, tx, `SELECT id FROM questions WHERE deleted_at < ?`, millis(before))
+		if err != nil {
+			return err
+		}
+
+		if len(questionIDs) > 0 {
+			in, args := placeholders(nil, questionIDs)
+			for _, statement := range []string{
+				`DELETE FROM comments WHERE question_id IN (` + in + `)`,
+				`DELETE FROM revisions WHERE question_id IN (` + in + `)`,
+			} {
+				if _, err := tx.ExecContext(ctx, statement, args...); err != nil {
+					return err
+				}
+			}
+
+			for _, statement := range []string{
+				`DELETE FROM answers WHERE question_id IN (` + in + `)`,
+				`DELETE FROM questions WHERE id IN (` + in + `)`,
+			} {
+				n, err := rowsAffected(tx.ExecContext(ctx, statement, args...))
+				if err != nil {
+					return err
+				}
+				purged += n
+			}
+		}
+
+		answerIDs, err := tombstoneIDs(ctx, tx, `SELECT id FROM answers WHERE deleted_at < ? AND deleted_with_question = 0`, millis(before))
+		if err != nil {
+			return err
+		}
+
+		if len(answerIDs) > 0 {
+			in, args := placeholders([]any{models.TargetAnswer}, answerIDs)
+			for _, statement := range []string{
+				`DELETE FROM comments WHERE target_type = ? AND target_id IN (` + in + `)`,
+				`DELETE FROM revisions WHERE target_type = ? AND target_id IN (` + in + `)`,
+			} {
+				if _, err := tx.ExecContext(ctx, statement, args...); err != nil {
+					return err
+				}
+			}
+
+			n, err := rowsAffected(tx.ExecContext(ctx, `DELETE FROM answers WHERE id IN (`+in+`)`, args[1:]...))
+			if err != nil {
+				return err
+			}
+			purged += n
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// tombstoneIDs returns the IDs selected by query.
+func tombstoneIDs(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func rowsAffected(result sql.Result, err error) (int64, error) {
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
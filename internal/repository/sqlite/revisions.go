@@ -0,0 +1,218 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+// EditQuestion keeps revision numbers distinct by writing the update and the
+// revision in one transaction.
+func (r *Repository) EditQuestion(ctx context.Context, questionID string, revision *models.Revision) (*models.Question, error) {
+	qID, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var edited *models.Question
+	err = r.tx(ctx, func(tx *sql.Tx) error {
+		previous, found, err := getQuestion(ctx, tx, `q.id = ? AND q.deleted_at IS NULL`, qID.Hex())
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("question not found")
+		}
+
+		updatedAt := now()
+		_, err = tx.ExecContext(ctx, `UPDATE questions SET question = ?, details = ?, updated_at = ?, last_edited_by = ?,
+			revision_count = revision_count + 1 WHERE id = ?`,
+			revision.Question, revision.Details, millis(updatedAt), revision.EditorID, qID.Hex())
+		if err != nil {
+			return err
+		}
+		if err := setTags(ctx, tx, qID.Hex(), revision.Tags); err != nil {
+			return err
+		}
+
+		revision.TargetType = models.TargetQuestion
+		revision.TargetID = qID
+		revision.QuestionID = qID
+		revision.CreatedAt = updatedAt
+
+		original := &models.Revision{
+			TargetType: models.TargetQuestion,
+			TargetID:   qID,
+			QuestionID: qID,
+			EditorID:   previous.UserID,
+			Question:   previous.Question,
+			Details:    previous.Details,
+			Tags:       previous.Tags,
+			CreatedAt:  previous.CreatedAt,
+		}
+
+		if err := recordRevision(ctx, tx, previous.RevisionCount, original, revision); err != nil {
+			return err
+		}
+
+		edited = previous
+		edited.Question = revision.Question
+		edited.Details = revision.Details
+		edited.Tags = revision.Tags
+		edited.UpdatedAt = updatedAt
+		edited.LastEditedBy = revision.EditorID
+		edited.RevisionCount++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return edited, nil
+}
+
+func (r *Repository) EditAnswer(ctx context.Context, questionID, answerID string, revision *models.Revision) (*models.Answer, error) {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var edited *models.Answer
+	err = r.tx(ctx, func(tx *sql.Tx) error {
+		previous, found, err := getAnswer(ctx, tx, liveAnswer, aID.Hex(), qID.Hex())
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("answer not found")
+		}
+
+		updatedAt := now()
+		_, err = tx.ExecContext(ctx, `UPDATE answers SET answer = ?, updated_at = ?, last_edited_by = ?,
+			revision_count = revision_count + 1 WHERE id = ?`,
+			revision.Answer, millis(updatedAt), revision.EditorID, aID.Hex())
+		if err != nil {
+			return err
+		}
+
+		revision.TargetType = models.TargetAnswer
+		revision.TargetID = previous.ID
+		revision.QuestionID = previous.QuestionID
+		revision.CreatedAt = updatedAt
+
+		original := &models.Revision{
+			TargetType: models.TargetAnswer,
+			TargetID:   previous.ID,
+			QuestionID: previous.QuestionID,
+			EditorID:   previous.UserID,
+			Answer:     previous.Answer,
+			CreatedAt:  previous.CreatedAt,
+		}
+
+		if err := recordRevision(ctx, tx, previous.RevisionCount, original, revision); err != nil {
+			return err
+		}
+
+		edited = previous
+		edited.Answer = revision.Answer
+		edited.UpdatedAt = updatedAt
+		edited.LastEditedBy = revision.EditorID
+		edited.RevisionCount++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return edited, nil
+}
+
+// recordRevision stores the revision produced by an edit. Posts are not
+// copied into revisions when created, so the first edit also stores the
+// original version as revision 1.
+func recordRevision(ctx context.Context, tx *sql.Tx, editsBefore int, original, revision *models.Revision) error {
+	revisions := make([]*models.Revision, 0, 2)
+	if editsBefore == 0 {
+		original.ID = primitive.NewObjectID()
+		original.Revision = 1
+		revisions = append(revisions, original)
+	}
+
+	revision.ID = primitive.NewObjectID()
+	revision.Revision = editsBefore + 2
+	revisions = append(revisions, revision)
+
+	for _, rev := range revisions {
+		var tags any
+		if len(rev.Tags) > 0 {
+			encoded, err := json.Marshal(rev.Tags)
+			if err != nil {
+				return err
+			}
+			tags = string(encoded)
+		}
+
+		_, err := tx.ExecContext(ctx, `INSERT INTO revisions (`+revisionColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rev.ID.Hex(), rev.TargetType, rev.TargetID.Hex(), rev.QuestionID.Hex(), rev.Revision, rev.EditorID,
+			rev.EditSummary, rev.Question, rev.Details, tags, rev.Answer, millis(rev.CreatedAt))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const revisionColumns = `id, target_type, target_id, question_id, revision, editor_id, edit_summary,
+	question, details, tags, answer, created_at`
+
+func (r *Repository) GetRevisions(ctx context.Context, targetType, targetID string) ([]models.Revision, error) {
+	id, err := mongodb.ParseID("target_id", targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT `+revisionColumns+` FROM revisions
+		WHERE target_type = ? AND target_id = ? ORDER BY revision`, targetType, id.Hex())
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	revisions := []models.Revision{}
+	for rows.Next() {
+		var (
+			rev                          models.Revision
+			revID, revTarget, questionID string
+			tags                         sql.NullString
+			createdAt                    int64
+		)
+		err := rows.Scan(&revID, &rev.TargetType, &revTarget, &questionID, &rev.Revision, &rev.EditorID,
+			&rev.EditSummary, &rev.Question, &rev.Details, &tags, &rev.Answer, &createdAt)
+		if err != nil {
+			return nil, dbError(err)
+		}
+
+		if rev.ID, err = parseID(revID); err != nil {
+			return nil, dbError(err)
+		}
+		if rev.TargetID, err = parseID(revTarget); err != nil {
+			return nil, dbError(err)
+		}
+		if rev.QuestionID, err = parseID(questionID); err != nil {
+			return nil, dbError(err)
+		}
+		if tags.Valid {
+			if err := json.Unmarshal([]byte(tags.String), &rev.Tags); err != nil {
+				return nil, dbError(err)
+			}
+		}
+		rev.CreatedAt = fromMillis(createdAt)
+		revisions = append(revisions, rev)
+	}
+
+	return revisions, dbError(rows.Err())
+}
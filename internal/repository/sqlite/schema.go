@@ -0,0 +1,264 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// migrations evolve the schema. New applies the ones a database has not
+// seen yet, in order, and records them in schema_migrations. Only ever
+// append to this list.
+//
+// Times are stored as Unix milliseconds, the precision MongoDB keeps, and
+// IDs as the hex of ObjectIDs so that models look the same with either
+// backend. Questions and answers have an integer seq so that the full-text
+// tables can refer to them by a rowid that VACUUM does not change.
+var migrations = []string{
+	// 1: initial schema
+	`
+CREATE TABLE questions (
+	seq                INTEGER PRIMARY KEY,
+	id                 TEXT    NOT NULL UNIQUE,
+	user_id            TEXT    NOT NULL,
+	question           TEXT    NOT NULL,
+	details            TEXT    NOT NULL,
+	answer_count       INTEGER NOT NULL DEFAULT 0,
+	accepted_answer_id TEXT,
+	is_answered        INTEGER NOT NULL DEFAULT 0,
+	is_flagged         INTEGER NOT NULL DEFAULT 0,
+	is_hidden          INTEGER NOT NULL DEFAULT 0,
+	auto_hidden        INTEGER NOT NULL DEFAULT 0,
+	comment_count      INTEGER NOT NULL DEFAULT 0,
+	revision_count     INTEGER NOT NULL DEFAULT 0,
+	last_edited_by     TEXT    NOT NULL DEFAULT '',
+	created_at         INTEGER NOT NULL,
+	updated_at         INTEGER NOT NULL,
+	deleted_at         INTEGER,
+	deleted_by         TEXT    NOT NULL DEFAULT '',
+	delete_reason      TEXT    NOT NULL DEFAULT ''
+);
+CREATE INDEX questions_created ON questions (created_at DESC, id DESC);
+CREATE INDEX questions_user ON questions (user_id, created_at DESC, id DESC);
+CREATE INDEX questions_flagged ON questions (is_flagged, created_at DESC);
+CREATE INDEX questions_deleted ON questions (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE TABLE question_tags (
+	question_id TEXT    NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+	position    INTEGER NOT NULL,
+	tag         TEXT    NOT NULL,
+	PRIMARY KEY (question_id, position)
+);
+CREATE INDEX question_tags_tag ON question_tags (tag);
+
+CREATE TABLE answers (
+	seq                   INTEGER PRIMARY KEY,
+	id                    TEXT    NOT NULL UNIQUE,
+	question_id           TEXT    NOT NULL REFERENCES questions (id),
+	user_id               TEXT    NOT NULL,
+	answer                TEXT    NOT NULL,
+	upvotes               INTEGER NOT NULL DEFAULT 0,
+	downvotes             INTEGER NOT NULL DEFAULT 0,
+	is_flagged            INTEGER NOT NULL DEFAULT 0,
+	is_hidden             INTEGER NOT NULL DEFAULT 0,
+	auto_hidden           INTEGER NOT NULL DEFAULT 0,
+	comment_count         INTEGER NOT NULL DEFAULT 0,
+	revision_count        INTEGER NOT NULL DEFAULT 0,
+	last_edited_by        TEXT    NOT NULL DEFAULT '',
+	created_at            INTEGER NOT NULL,
+	updated_at            INTEGER NOT NULL,
+	deleted_at            INTEGER,
+	deleted_by            TEXT    NOT NULL DEFAULT '',
+	delete_reason         TEXT    NOT NULL DEFAULT '',
+	deleted_with_question INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX answers_question ON answers (question_id, created_at, id);
+CREATE INDEX answers_flagged ON answers (is_flagged, created_at DESC);
+CREATE INDEX answers_deleted ON answers (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE TABLE votes (
+	seq       INTEGER PRIMARY KEY,
+	answer_id TEXT    NOT NULL REFERENCES answers (id) ON DELETE CASCADE,
+	user_id   TEXT    NOT NULL,
+	vote_type TEXT    NOT NULL,
+	voted_at  INTEGER NOT NULL,
+	UNIQUE (answer_id, user_id)
+);
+
+-- Flags belong to a question, answer or comment. Each user has at most one
+-- open flag on a post.
+CREATE TABLE flags (
+	id              INTEGER PRIMARY KEY,
+	target_type     TEXT    NOT NULL,
+	target_id       TEXT    NOT NULL,
+	user_id         TEXT    NOT NULL,
+	reason          TEXT    NOT NULL,
+	details         TEXT    NOT NULL DEFAULT '',
+	weight          REAL    NOT NULL DEFAULT 0,
+	created_at      INTEGER NOT NULL,
+	resolution      TEXT    NOT NULL DEFAULT '',
+	resolved_by     TEXT    NOT NULL DEFAULT '',
+	resolved_at     INTEGER,
+	resolution_note TEXT    NOT NULL DEFAULT ''
+);
+CREATE INDEX flags_target ON flags (target_type, target_id);
+CREATE UNIQUE INDEX flags_open ON flags (target_type, target_id, user_id) WHERE resolved_at IS NULL;
+
+CREATE TABLE tags (
+	id          TEXT    PRIMARY KEY,
+	name        TEXT    NOT NULL UNIQUE,
+	description TEXT    NOT NULL DEFAULT '',
+	created_at  INTEGER NOT NULL
+);
+CREATE INDEX tags_created ON tags (created_at DESC, id DESC);
+
+CREATE TABLE revisions (
+	id           TEXT    PRIMARY KEY,
+	target_type  TEXT    NOT NULL,
+	target_id    TEXT    NOT NULL,
+	question_id  TEXT    NOT NULL,
+	revision     INTEGER NOT NULL,
+	editor_id    TEXT    NOT NULL,
+	edit_summary TEXT    NOT NULL DEFAULT '',
+	question     TEXT    NOT NULL DEFAULT '',
+	details      TEXT    NOT NULL DEFAULT '',
+	tags         TEXT,
+	answer       TEXT    NOT NULL DEFAULT '',
+	created_at   INTEGER NOT NULL,
+	UNIQUE (target_type, target_id, revision)
+);
+CREATE INDEX revisions_question ON revisions (question_id);
+
+CREATE TABLE comments (
+	id          TEXT    PRIMARY KEY,
+	target_type TEXT    NOT NULL,
+	target_id   TEXT    NOT NULL,
+	question_id TEXT    NOT NULL,
+	parent_id   TEXT,
+	user_id     TEXT    NOT NULL,
+	body        TEXT    NOT NULL,
+	is_flagged  INTEGER NOT NULL DEFAULT 0,
+	is_hidden   INTEGER NOT NULL DEFAULT 0,
+	auto_hidden INTEGER NOT NULL DEFAULT 0,
+	created_at  INTEGER NOT NULL,
+	updated_at  INTEGER NOT NULL
+);
+CREATE INDEX comments_target ON comments (target_type, target_id, created_at DESC, id DESC);
+CREATE INDEX comments_question ON comments (question_id);
+
+-- comment_ancestors lists every comment above a reply, root first.
+CREATE TABLE comment_ancestors (
+	comment_id  TEXT    NOT NULL REFERENCES comments (id) ON DELETE CASCADE,
+	depth       INTEGER NOT NULL,
+	ancestor_id TEXT    NOT NULL,
+	PRIMARY KEY (comment_id, depth)
+);
+CREATE INDEX comment_ancestors_ancestor ON comment_ancestors (ancestor_id);
+
+CREATE TABLE follows (
+	id          TEXT    PRIMARY KEY,
+	user_id     TEXT    NOT NULL,
+	target_type TEXT    NOT NULL,
+	target      TEXT    NOT NULL,
+	created_at  INTEGER NOT NULL,
+	UNIQUE (user_id, target_type, target)
+);
+CREATE INDEX follows_user ON follows (user_id, created_at DESC, id DESC);
+
+CREATE TABLE warnings (
+	id           TEXT    PRIMARY KEY,
+	user_id      TEXT    NOT NULL,
+	moderator_id TEXT    NOT NULL,
+	target_type  TEXT    NOT NULL,
+	target_id    TEXT    NOT NULL,
+	reason       TEXT    NOT NULL,
+	created_at   INTEGER NOT NULL
+);
+CREATE INDEX warnings_user ON warnings (user_id, created_at DESC);
+
+CREATE TABLE audit_events (
+	id          TEXT    PRIMARY KEY,
+	actor_id    TEXT    NOT NULL,
+	actor_role  TEXT    NOT NULL,
+	action      TEXT    NOT NULL,
+	target_type TEXT    NOT NULL,
+	target_id   TEXT    NOT NULL,
+	reason      TEXT    NOT NULL DEFAULT '',
+	before      BLOB,
+	after       BLOB,
+	method      TEXT    NOT NULL DEFAULT '',
+	peer_addr   TEXT    NOT NULL DEFAULT '',
+	user_agent  TEXT    NOT NULL DEFAULT '',
+	request_id  TEXT    NOT NULL DEFAULT '',
+	created_at  INTEGER NOT NULL
+);
+CREATE INDEX audit_events_created ON audit_events (created_at DESC, id DESC);
+CREATE INDEX audit_events_actor ON audit_events (actor_id, created_at DESC);
+CREATE INDEX audit_events_target ON audit_events (target_type, target_id, created_at DESC);
+
+-- Full-text indexes, kept in step with their tables by triggers. Removing a
+-- post also removes its flags.
+CREATE VIRTUAL TABLE questions_fts USING fts4(question, details, tokenize=porter);
+CREATE VIRTUAL TABLE answers_fts USING fts4(answer, tokenize=porter);
+
+CREATE TRIGGER questions_insert AFTER INSERT ON questions BEGIN
+	INSERT INTO questions_fts (docid, question, details) VALUES (new.seq, new.question, new.details);
+END;
+CREATE TRIGGER questions_update AFTER UPDATE OF question, details ON questions BEGIN
+	UPDATE questions_fts SET question = new.question, details = new.details WHERE docid = new.seq;
+END;
+CREATE TRIGGER questions_delete AFTER DELETE ON questions BEGIN
+	DELETE FROM questions_fts WHERE docid = old.seq;
+	DELETE FROM flags WHERE target_type = 'question' AND target_id = old.id;
+END;
+
+CREATE TRIGGER answers_insert AFTER INSERT ON answers BEGIN
+	INSERT INTO answers_fts (docid, answer) VALUES (new.seq, new.answer);
+END;
+CREATE TRIGGER answers_update AFTER UPDATE OF answer ON answers BEGIN
+	UPDATE answers_fts SET answer = new.answer WHERE docid = new.seq;
+END;
+CREATE TRIGGER answers_delete AFTER DELETE ON answers BEGIN
+	DELETE FROM answers_fts WHERE docid = old.seq;
+	DELETE FROM flags WHERE target_type = 'answer' AND target_id = old.id;
+END;
+
+CREATE TRIGGER comments_delete AFTER DELETE ON comments BEGIN
+	DELETE FROM flags WHERE target_type = 'comment' AND target_id = old.id;
+END;
+`,
+	// 2: is_answered used to be settable without an accepted answer
+	`UPDATE questions SET is_answered = accepted_answer_id IS NOT NULL;`,
+	// 3: deleted comments are kept as tombstones
+	`ALTER TABLE comments ADD COLUMN deleted_at INTEGER;`,
+}
+
+// migrate applies the migrations the database has not seen yet.
+func migrate(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    INTEGER PRIMARY KEY,
+	applied_at INTEGER NOT NULL
+)`)
+	if err != nil {
+		return err
+	}
+
+	var applied int
+	if err := db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&applied); err != nil {
+		return err
+	}
+
+	for version := applied + 1; version <= len(migrations); version++ {
+		err := withTx(ctx, db, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, migrations[version-1]); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)`, version, millis(now()))
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("migration %d: %w", version, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+	"strings"
+
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+// Search weights. Matches in a question title count for more than matches
+// in its details, and answer scores are scaled relative to both.
+const (
+	titleWeight       = 10
+	detailsWeight     = 4
+	answerScoreWeight = 2
+)
+
+// matchQuery turns a keyword into an FTS query matching any of its terms.
+// Each term is quoted so that callers cannot inject FTS operators.
+func matchQuery(keyword string) string {
+	terms := mongodb.SearchTerms(keyword)
+	for i, term := range terms {
+		terms[i] = `"` + term + `"`
+	}
+	return strings.Join(terms, " OR ")
+}
+
+// matchScore weighs the hits in each column of a row, as reported by
+// matchinfo(table, 'pcx'), by weights. It is registered with SQLite as
+// match_score so that queries can rank and limit their candidates.
+func matchScore(info []byte, weights ...float64) float64 {
+	values := make([]uint32, len(info)/4)
+	for i := range values {
+		values[i] = binary.NativeEndian.Uint32(info[i*4:])
+	}
+	if len(values) < 2 {
+		return 0
+	}
+
+	phrases, columns := int(values[0]), int(values[1])
+	var score float64
+	for p := 0; p < phrases; p++ {
+		for c := 0; c < columns && c < len(weights); c++ {
+			if i := 2 + 3*(p*columns+c); i < len(values) {
+				score += weights[c] * float64(values[i])
+			}
+		}
+	}
+	return score
+}
+
+// SearchQuestionsAnswersUsers reads candidates from the FTS4 indexes.
+func (r *Repository) SearchQuestionsAnswersUsers(ctx context.Context, keyword string, limit, offset int) (*models.SearchResult, error) {
+	match := matchQuery(keyword)
+	if match == "" {
+		return &models.SearchResult{}, nil
+	}
+
+	type scored struct {
+		questionID    string
+		questionScore float64
+		answerScore   float64
+		answerID      string
+	}
+	byQuestion := make(map[string]*scored)
+
+	rows, err := r.db.QueryContext(ctx, `SELECT q.id, match_score(matchinfo(questions_fts, 'pcx'), ?, ?) AS score
+		FROM questions_fts JOIN questions q ON q.seq = questions_fts.docid
+		WHERE questions_fts MATCH ? AND q.deleted_at IS NULL AND q.is_hidden = 0
+		ORDER BY score DESC LIMIT ?`, float64(titleWeight), float64(detailsWeight), match, mongodb.SearchCandidateLimit)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	for rows.Next() {
+		var id string
+		var score float64
+		if err := rows.Scan(&id, &score); err != nil {
+			rows.Close()
+			return nil, dbError(err)
+		}
+		byQuestion[id] = &scored{questionID: id, questionScore: score}
+	}
+	if err := rows.Close(); err != nil {
+		return nil, dbError(err)
+	}
+
+	// Answers of deleted or hidden questions are left out here so that they
+	// count towards neither the ranking nor the total.
+	rows, err = r.db.QueryContext(ctx, `SELECT a.id, a.question_id, match_score(matchinfo(answers_fts, 'pcx'), 1.0) AS score
+		FROM answers_fts JOIN answers a ON a.seq = answers_fts.docid
+		JOIN questions q ON q.id = a.question_id
+		WHERE answers_fts MATCH ? AND a.deleted_at IS NULL AND a.is_hidden = 0
+		AND q.deleted_at IS NULL AND q.is_hidden = 0
+		ORDER BY score DESC LIMIT ?`, match, mongodb.SearchCandidateLimit)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	for rows.Next() {
+		var id, questionID string
+		var score float64
+		if err := rows.Scan(&id, &questionID, &score); err != nil {
+			rows.Close()
+			return nil, dbError(err)
+		}
+
+		entry, ok := byQuestion[questionID]
+		if !ok {
+			entry = &scored{questionID: questionID}
+			byQuestion[questionID] = entry
+		}
+		// Only the best answer contributes so that a question cannot
+		// outrank others simply by having many mediocre answers.
+		if entry.answerID == "" || score > entry.answerScore {
+			entry.answerID = id
+			entry.answerScore = score
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return nil, dbError(err)
+	}
+
+	ranked := make([]*scored, 0, len(byQuestion))
+	for _, entry := range byQuestion {
+		ranked = append(ranked, entry)
+	}
+	score := func(entry *scored) float64 {
+		return entry.questionScore + answerScoreWeight*entry.answerScore
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if si, sj := score(ranked[i]), score(ranked[j]); si != sj {
+			return si > sj
+		}
+		return ranked[i].questionID > ranked[j].questionID
+	})
+
+	result := &models.SearchResult{Total: int64(len(ranked))}
+	if offset >= len(ranked) {
+		return result, nil
+	}
+	ranked = ranked[offset:]
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+		result.Next = offset + limit
+	}
+
+	questionIDs := make([]string, len(ranked))
+	var answerIDs []string
+	for i, entry := range ranked {
+		questionIDs[i] = entry.questionID
+		if entry.answerID != "" {
+			answerIDs = append(answerIDs, entry.answerID)
+		}
+	}
+
+	in, args := placeholders(nil, questionIDs)
+	questions, err := queryQuestions(ctx, r.db, `SELECT `+questionColumns+` FROM questions q
+		WHERE q.id IN (`+in+`) AND q.deleted_at IS NULL AND q.is_hidden = 0`, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	found := make(map[string]models.Question, len(questions))
+	for _, question := range questions {
+		found[question.ID.Hex()] = question
+	}
+
+	in, args = placeholders(nil, answerIDs)
+	answers, err := queryAnswers(ctx, r.db, `SELECT `+answerColumns+` FROM answers a WHERE a.id IN (`+in+`)`, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	bestAnswers := make(map[string]*models.Answer, len(answers))
+	for i := range answers {
+		bestAnswers[answers[i].ID.Hex()] = &answers[i]
+	}
+
+	// A question deleted or hidden since it was ranked is skipped
+	for _, entry := range ranked {
+		question, ok := found[entry.questionID]
+		if !ok {
+			continue
+		}
+		result.Hits = append(result.Hits, models.SearchHit{
+			Question: question,
+			Answer:   bestAnswers[entry.answerID],
+			Score:    score(entry),
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,676 @@
+// Package sqlite is a Repository backed by SQLite. Questions, answers,
+// votes, flags and tags live in normalized tables, the schema is migrated
+// when the database is opened and search uses SQLite's FTS4 full-text
+// indexes. It behaves like the MongoDB repository and passes the same
+// conformance suite.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+)
+
+var _ mongodb.Repository = (*Repository)(nil)
+
+// driverName is the sqlite3 driver with the repository's SQL functions.
+const driverName = "sqlite3_content"
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("match_score", matchScore, true)
+		},
+	})
+}
+
+// Repository stores content in a SQLite database. SQLite allows one writer
+// at a time, so the repository uses a single connection and every change
+// that touches several rows runs in a transaction on it.
+type Repository struct {
+	db *sql.DB
+}
+
+// New opens the database at path, creating it if needed, and brings its
+// schema up to date. A path of ":memory:" gives a private in-memory
+// database.
+func New(path string) (*Repository, error) {
+	db, err := sql.Open(driverName, "file:"+path+"?_foreign_keys=on&_busy_timeout=5000&_journal_mode=WAL")
+	if err != nil {
+		return nil, dbError(err)
+	}
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxLifetime(0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := migrate(ctx, db); err != nil {
+		db.Close()
+		return nil, dbError(err)
+	}
+
+	return &Repository{db: db}, nil
+}
+
+func (r *Repository) Ping(ctx context.Context) error {
+	return dbError(r.db.PingContext(ctx))
+}
+
+func (r *Repository) Close(ctx context.Context) error {
+	return r.db.Close()
+}
+
+// querier is implemented by both *sql.DB and *sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// scanner is implemented by both *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func withTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// tx runs fn in a transaction. Queries inside fn must use tx: the
+// repository's only connection is taken until fn returns.
+func (r *Repository) tx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	return dbError(withTx(ctx, r.db, fn))
+}
+
+// dbError gives SQLite driver errors a kind.
+func dbError(err error) error {
+	return errs.FromDriver(err, func(err error) (errs.Kind, string, bool) {
+		var sqliteErr sqlite3.Error
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return errs.NotFound, "not found", true
+		case !errors.As(err, &sqliteErr):
+			return errs.Internal, "", false
+		case sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique, sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey:
+			return errs.AlreadyExists, "already exists", true
+		case sqliteErr.Code == sqlite3.ErrBusy, sqliteErr.Code == sqlite3.ErrLocked:
+			return errs.Unavailable, "database unavailable", true
+		default:
+			return errs.Internal, "", false
+		}
+	})
+}
+
+func isUniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+}
+
+// parseID reads an ID stored by the repository.
+func parseID(hex string) (primitive.ObjectID, error) {
+	return primitive.ObjectIDFromHex(hex)
+}
+
+func now() time.Time {
+	return time.Now()
+}
+
+// millis and fromMillis convert between times and stored Unix milliseconds.
+func millis(t time.Time) int64 {
+	return t.UnixMilli()
+}
+
+func fromMillis(ms int64) time.Time {
+	return time.UnixMilli(ms).UTC()
+}
+
+func nullMillis(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return millis(*t)
+}
+
+func fromNullMillis(ms sql.NullInt64) *time.Time {
+	if !ms.Valid {
+		return nil
+	}
+	t := fromMillis(ms.Int64)
+	return &t
+}
+
+// placeholders returns n comma-separated parameters and appends values to
+// args.
+func placeholders[T any](args []any, values []T) (string, []any) {
+	marks := make([]string, len(values))
+	for i, value := range values {
+		marks[i] = "?"
+		args = append(args, value)
+	}
+	return strings.Join(marks, ", "), args
+}
+
+// affected reports whether a statement changed any rows.
+func affected(result sql.Result, err error) (bool, error) {
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+const questionColumns = `q.id, q.user_id, q.question, q.details, q.answer_count, q.accepted_answer_id,
+	q.is_answered, q.is_flagged, q.is_hidden, q.auto_hidden, q.comment_count, q.revision_count,
+	q.last_edited_by, q.created_at, q.updated_at, q.deleted_at, q.deleted_by, q.delete_reason`
+
+func scanQuestion(s scanner) (models.Question, error) {
+	var (
+		q                    models.Question
+		id                   string
+		accepted             sql.NullString
+		createdAt, updatedAt int64
+		deletedAt            sql.NullInt64
+	)
+	err := s.Scan(&id, &q.UserID, &q.Question, &q.Details, &q.AnswerCount, &accepted,
+		&q.IsAnswered, &q.IsFlagged, &q.IsHidden, &q.AutoHidden, &q.CommentCount, &q.RevisionCount,
+		&q.LastEditedBy, &createdAt, &updatedAt, &deletedAt, &q.DeletedBy, &q.DeleteReason)
+	if err != nil {
+		return q, err
+	}
+
+	if q.ID, err = parseID(id); err != nil {
+		return q, err
+	}
+	if accepted.Valid {
+		acceptedID, err := parseID(accepted.String)
+		if err != nil {
+			return q, err
+		}
+		q.AcceptedAnswerID = &acceptedID
+	}
+	q.CreatedAt = fromMillis(createdAt)
+	q.UpdatedAt = fromMillis(updatedAt)
+	q.DeletedAt = fromNullMillis(deletedAt)
+	return q, nil
+}
+
+// queryQuestions runs a query selecting questionColumns and loads the tags
+// and flags of the questions it returns.
+func queryQuestions(ctx context.Context, q querier, query string, args ...any) ([]models.Question, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var questions []models.Question
+	for rows.Next() {
+		question, err := scanQuestion(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		questions = append(questions, question)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(questions) == 0 {
+		return questions, nil
+	}
+
+	ids := make([]string, len(questions))
+	for i := range questions {
+		ids[i] = questions[i].ID.Hex()
+	}
+
+	tags, err := loadTags(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+	flags, err := loadFlags(ctx, q, models.TargetQuestion, ids, false)
+	if err != nil {
+		return nil, err
+	}
+	for i := range questions {
+		questions[i].Tags = tags[ids[i]]
+		questions[i].Flags = flags[ids[i]]
+	}
+	return questions, nil
+}
+
+// getQuestion returns the question matching the conditions, if any.
+func getQuestion(ctx context.Context, q querier, where string, args ...any) (*models.Question, bool, error) {
+	questions, err := queryQuestions(ctx, q, `SELECT `+questionColumns+` FROM questions q WHERE `+where, args...)
+	if err != nil || len(questions) == 0 {
+		return nil, false, err
+	}
+	return &questions[0], true, nil
+}
+
+func loadTags(ctx context.Context, q querier, questionIDs []string) (map[string][]string, error) {
+	in, args := placeholders(nil, questionIDs)
+	rows, err := q.QueryContext(ctx, `SELECT question_id, tag FROM question_tags WHERE question_id IN (`+in+`) ORDER BY question_id, position`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make(map[string][]string, len(questionIDs))
+	for rows.Next() {
+		var questionID, tag string
+		if err := rows.Scan(&questionID, &tag); err != nil {
+			return nil, err
+		}
+		tags[questionID] = append(tags[questionID], tag)
+	}
+	return tags, rows.Err()
+}
+
+func setTags(ctx context.Context, tx *sql.Tx, questionID string, tags []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM question_tags WHERE question_id = ?`, questionID); err != nil {
+		return err
+	}
+
+	for i, tag := range tags {
+		_, err := tx.ExecContext(ctx, `INSERT INTO question_tags (question_id, position, tag) VALUES (?, ?, ?)`, questionID, i, tag)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listQuestions returns a page of the live, visible questions that match
+// the extra conditions.
+func (r *Repository) listQuestions(ctx context.Context, where string, args []any, page models.Page) (*models.QuestionPage, error) {
+	clause, args := pageClause("q.created_at", "q.id", page, args)
+	questions, err := queryQuestions(ctx, r.db, `SELECT `+questionColumns+` FROM questions q
+		WHERE q.deleted_at IS NULL AND q.is_hidden = 0 AND `+where+clause, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return questionPage(questions, page), nil
+}
+
+func (r *Repository) PostQuestion(ctx context.Context, question *models.Question) error {
+	question.ID = primitive.NewObjectID()
+	question.CreatedAt = now()
+	question.IsAnswered = false
+	question.AnswerCount = 0
+
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		var accepted any
+		if question.AcceptedAnswerID != nil {
+			accepted = question.AcceptedAnswerID.Hex()
+		}
+
+		id := question.ID.Hex()
+		_, err := tx.ExecContext(ctx, `INSERT INTO questions (id, user_id, question, details, answer_count,
+			accepted_answer_id, is_answered, is_flagged, is_hidden, auto_hidden, comment_count, revision_count,
+			last_edited_by, created_at, updated_at, deleted_at, deleted_by, delete_reason)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			id, question.UserID, question.Question, question.Details, question.AnswerCount,
+			accepted, question.IsAnswered, question.IsFlagged, question.IsHidden, question.AutoHidden,
+			question.CommentCount, question.RevisionCount, question.LastEditedBy,
+			millis(question.CreatedAt), millis(question.UpdatedAt), nullMillis(question.DeletedAt),
+			question.DeletedBy, question.DeleteReason)
+		if err != nil {
+			return err
+		}
+
+		if err := setTags(ctx, tx, id, question.Tags); err != nil {
+			return err
+		}
+		return insertFlags(ctx, tx, models.TargetQuestion, id, question.Flags)
+	})
+}
+
+func (r *Repository) GetQuestionsByUserID(ctx context.Context, userID string, page models.Page) (*models.QuestionPage, error) {
+	return r.listQuestions(ctx, `q.user_id = ?`, []any{userID}, page)
+}
+
+func (r *Repository) GetQuestionsByTags(ctx context.Context, tags []string, page models.Page) (*models.QuestionPage, error) {
+	in, args := placeholders(nil, tags)
+	return r.listQuestions(ctx, `q.id IN (SELECT question_id FROM question_tags WHERE tag IN (`+in+`))`, args, page)
+}
+
+func (r *Repository) GetQuestionsByWord(ctx context.Context, word string, page models.Page) (*models.QuestionPage, error) {
+	match := matchQuery(word)
+	if match == "" {
+		return &models.QuestionPage{}, nil
+	}
+
+	return r.listQuestions(ctx, `q.seq IN (SELECT docid FROM questions_fts WHERE questions_fts MATCH ?)`, []any{match}, page)
+}
+
+func (r *Repository) DeleteQuestion(ctx context.Context, questionID, deletedBy, reason string) error {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return err
+	}
+
+	deletedAt := millis(now())
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		found, err := affected(tx.ExecContext(ctx, `UPDATE questions SET deleted_at = ?, deleted_by = ?, delete_reason = ?
+			WHERE id = ? AND deleted_at IS NULL`, deletedAt, deletedBy, reason, id.Hex()))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("question not found")
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE answers SET deleted_at = ?, deleted_by = ?, deleted_with_question = 1
+			WHERE question_id = ? AND deleted_at IS NULL`, deletedAt, deletedBy, id.Hex())
+		return err
+	})
+}
+
+func (r *Repository) GetDeletedQuestion(ctx context.Context, questionID string) (*models.Question, error) {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	question, found, err := getQuestion(ctx, r.db, `q.id = ? AND q.deleted_at IS NOT NULL`, id.Hex())
+	if err != nil {
+		return nil, dbError(err)
+	}
+	if !found {
+		return nil, errs.NotFoundf("deleted question not found")
+	}
+	return question, nil
+}
+
+func (r *Repository) RestoreQuestion(ctx context.Context, questionID string) (*models.Question, error) {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var question *models.Question
+	err = r.tx(ctx, func(tx *sql.Tx) error {
+		found, err := affected(tx.ExecContext(ctx, `UPDATE questions SET deleted_at = NULL, deleted_by = '', delete_reason = ''
+			WHERE id = ? AND deleted_at IS NOT NULL`, id.Hex()))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("deleted question not found")
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE answers SET deleted_at = NULL, deleted_by = '', deleted_with_question = 0
+			WHERE question_id = ? AND deleted_with_question = 1`, id.Hex())
+		if err != nil {
+			return err
+		}
+
+		question, _, err = getQuestion(ctx, tx, `q.id = ?`, id.Hex())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+func (r *Repository) GetQuestionByID(ctx context.Context, questionID string) (*models.Question, error) {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	question, found, err := getQuestion(ctx, r.db, `q.id = ? AND q.deleted_at IS NULL`, id.Hex())
+	if err != nil {
+		return nil, dbError(err)
+	}
+	if !found {
+		return nil, errs.NotFoundf("question not found")
+	}
+	return question, nil
+}
+
+func (r *Repository) GetUserIDFromQuestionID(ctx context.Context, questionID string) (string, error) {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return "", err
+	}
+
+	var userID string
+	err = r.db.QueryRowContext(ctx, `SELECT user_id FROM questions WHERE id = ? AND deleted_at IS NULL`, id.Hex()).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", errs.NotFoundf("question not found")
+	}
+	return userID, dbError(err)
+}
+
+func (r *Repository) FlagQuestion(ctx context.Context, questionID string, flag models.Flag, hide models.AutoHide) error {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return err
+	}
+
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		return addFlag(ctx, tx, postTables[models.TargetQuestion], id.Hex(), flag, hide)
+	})
+}
+
+func (r *Repository) MarkQuestionAsAnswered(ctx context.Context, questionID string) error {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return err
+	}
+
+	question, err := r.GetQuestionByID(ctx, id.Hex())
+	if err != nil {
+		return err
+	}
+	if question.AcceptedAnswerID == nil {
+		return errs.FailedPreconditionf("question has no accepted answer; accept one with AcceptAnswer")
+	}
+	return nil
+}
+
+func (r *Repository) AcceptAnswer(ctx context.Context, questionID, answerID string) error {
+	qID, aID, err := answerIDs(questionID, answerID)
+	if err != nil {
+		return err
+	}
+
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		if _, found, err := getAnswer(ctx, tx, liveAnswer, aID.Hex(), qID.Hex()); err != nil || !found {
+			if err == nil {
+				err = errs.NotFoundf("answer not found")
+			}
+			return err
+		}
+
+		found, err := affected(tx.ExecContext(ctx, `UPDATE questions SET accepted_answer_id = ?, is_answered = 1
+			WHERE id = ? AND deleted_at IS NULL`, aID.Hex(), qID.Hex()))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errs.NotFoundf("question not found")
+		}
+		return nil
+	})
+}
+
+func (r *Repository) UnacceptAnswer(ctx context.Context, questionID string) error {
+	id, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return err
+	}
+
+	found, err := affected(r.db.ExecContext(ctx, `UPDATE questions SET accepted_answer_id = NULL, is_answered = 0
+		WHERE id = ? AND accepted_answer_id IS NOT NULL AND deleted_at IS NULL`, id.Hex()))
+	if err != nil {
+		return dbError(err)
+	}
+
+	if !found {
+		if _, err := r.GetUserIDFromQuestionID(ctx, questionID); err != nil {
+			return err
+		}
+		return errs.NotFoundf("question has no accepted answer")
+	}
+	return nil
+}
+
+func (r *Repository) GetFlaggedQuestions(ctx context.Context, page models.Page) (*models.QuestionPage, error) {
+	const match = `q.is_flagged = 1 AND q.deleted_at IS NULL`
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM questions q WHERE `+match).Scan(&total); err != nil {
+		return nil, dbError(err)
+	}
+
+	clause, args := pageClause("q.created_at", "q.id", page, nil)
+	questions, err := queryQuestions(ctx, r.db, `SELECT `+questionColumns+` FROM questions q WHERE `+match+clause, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	result := questionPage(questions, page)
+	result.Total = total
+	return result, nil
+}
+
+const tagColumns = `id, name, description, created_at`
+
+func scanTag(s scanner) (models.Tag, error) {
+	var (
+		tag       models.Tag
+		id        string
+		createdAt int64
+	)
+	if err := s.Scan(&id, &tag.Name, &tag.Description, &createdAt); err != nil {
+		return tag, err
+	}
+
+	var err error
+	tag.ID, err = parseID(id)
+	tag.CreatedAt = fromMillis(createdAt)
+	return tag, err
+}
+
+func (r *Repository) queryTags(ctx context.Context, query string, args ...any) ([]models.Tag, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	var tags []models.Tag
+	for rows.Next() {
+		tag, err := scanTag(rows)
+		if err != nil {
+			return nil, dbError(err)
+		}
+		tags = append(tags, tag)
+	}
+	return tags, dbError(rows.Err())
+}
+
+func (r *Repository) AddTag(ctx context.Context, tag *models.Tag) error {
+	tag.ID = primitive.NewObjectID()
+	tag.CreatedAt = now()
+
+	_, err := r.db.ExecContext(ctx, `INSERT INTO tags (`+tagColumns+`) VALUES (?, ?, ?, ?)`,
+		tag.ID.Hex(), tag.Name, tag.Description, millis(tag.CreatedAt))
+	if isUniqueViolation(err) {
+		return errs.AlreadyExistsf("tag already exists")
+	}
+	return dbError(err)
+}
+
+func (r *Repository) RemoveTag(ctx context.Context, tagName string) error {
+	found, err := affected(r.db.ExecContext(ctx, `DELETE FROM tags WHERE name = ?`, tagName))
+	if err != nil {
+		return dbError(err)
+	}
+	if !found {
+		return errs.NotFoundf("tag not found")
+	}
+	return nil
+}
+
+func (r *Repository) UpdateTag(ctx context.Context, tagName, description string) (*models.Tag, error) {
+	found, err := affected(r.db.ExecContext(ctx, `UPDATE tags SET description = ? WHERE name = ?`, description, tagName))
+	if err != nil {
+		return nil, dbError(err)
+	}
+	if !found {
+		return nil, errs.NotFoundf("tag not found")
+	}
+	return r.GetTag(ctx, tagName)
+}
+
+func (r *Repository) GetTag(ctx context.Context, tagName string) (*models.Tag, error) {
+	tag, err := scanTag(r.db.QueryRowContext(ctx, `SELECT `+tagColumns+` FROM tags WHERE name = ?`, tagName))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.NotFoundf("tag not found")
+	}
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return &tag, nil
+}
+
+func (r *Repository) ListTags(ctx context.Context, page models.Page) (*models.TagPage, error) {
+	clause, args := pageClause("created_at", "id", page, nil)
+	tags, err := r.queryTags(ctx, `SELECT `+tagColumns+` FROM tags WHERE 1 = 1`+clause, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return tagPage(tags, page), nil
+}
+
+func (r *Repository) GetTagsByNames(ctx context.Context, tagNames []string) ([]models.Tag, error) {
+	in, args := placeholders(nil, tagNames)
+	return r.queryTags(ctx, `SELECT `+tagColumns+` FROM tags WHERE name IN (`+in+`) ORDER BY id`, args...)
+}
+
+func (r *Repository) EnsureTags(ctx context.Context, tagNames []string) error {
+	if len(tagNames) == 0 {
+		return nil
+	}
+
+	createdAt := millis(now())
+	return r.tx(ctx, func(tx *sql.Tx) error {
+		for _, name := range tagNames {
+			_, err := tx.ExecContext(ctx, `INSERT INTO tags (`+tagColumns+`) VALUES (?, ?, '', ?) ON CONFLICT (name) DO NOTHING`,
+				primitive.NewObjectID().Hex(), name, createdAt)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,24 @@
+package sqlite_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+	"github.com/liju-github/ContentService/internal/repository/repotest"
+	"github.com/liju-github/ContentService/internal/repository/sqlite"
+)
+
+func TestConformance(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) mongodb.Repository {
+		repo, err := sqlite.New(filepath.Join(t.TempDir(), "test.db"))
+		if err != nil {
+			t.Fatalf("opening database: %v", err)
+		}
+		t.Cleanup(func() {
+			repo.Close(context.Background())
+		})
+		return repo
+	})
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/liju-github/ContentService/internal/auth"
+	"github.com/liju-github/ContentService/internal/models"
+	"github.com/liju-github/ContentService/internal/policy"
+	contentPB "github.com/liju-github/ContentService/proto/content"
+)
+
+// audit records a privileged change in the audit log, filling in the actor
+// and request from ctx. It runs after the change has been made, so a
+// failure to record it is logged rather than returned to the caller.
+func (s *ContentService) audit(ctx context.Context, event models.AuditEvent) {
+	if id, ok := auth.FromContext(ctx); ok {
+		event.ActorID = id.UserID
+	}
+	event.ActorRole = policy.RoleFromContext(ctx).String()
+	event.Request = requestMetadata(ctx)
+
+	if err := s.repo.RecordAuditEvent(ctx, &event); err != nil {
+		log.Printf("audit: failed to record %s of %s %s by %s: %v", event.Action, event.TargetType, event.TargetID, event.ActorID, err)
+	}
+}
+
+// auditQuestionChange records a change to a question whose state before
+// the change is before. The question is read again for the After snapshot.
+func (s *ContentService) auditQuestionChange(ctx context.Context, action string, before *models.Question) {
+	event := models.AuditEvent{
+		Action:     action,
+		TargetType: models.TargetQuestion,
+		TargetID:   before.ID.Hex(),
+		Before:     snapshot(before),
+	}
+	if after, err := s.repo.GetQuestionByID(ctx, before.ID.Hex()); err == nil {
+		event.After = snapshot(after)
+	}
+	s.audit(ctx, event)
+}
+
+// snapshot encodes v for the Before or After of an audit event.
+func snapshot(v any) bson.Raw {
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		log.Printf("audit: failed to snapshot %T: %v", v, err)
+		return nil
+	}
+	return raw
+}
+
+func requestMetadata(ctx context.Context) models.RequestMetadata {
+	var meta models.RequestMetadata
+	meta.Method, _ = grpc.Method(ctx)
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		meta.PeerAddr = p.Addr.String()
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("user-agent"); len(values) > 0 {
+		meta.UserAgent = values[0]
+	}
+	if values := md.Get("x-request-id"); len(values) > 0 {
+		meta.RequestID = values[0]
+	}
+	return meta
+}
+
+func (s *ContentService) ListAuditEvents(ctx context.Context, req *contentPB.ListAuditEventsRequest) (*contentPB.ListAuditEventsResponse, error) {
+	query := models.AuditQuery{
+		ActorID:    strings.TrimSpace(req.ActorID),
+		Action:     strings.TrimSpace(req.Action),
+		TargetType: strings.ToLower(strings.TrimSpace(req.TargetType)),
+		TargetID:   strings.TrimSpace(req.TargetID),
+	}
+	if req.Since > 0 {
+		query.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		query.Until = time.Unix(req.Until, 0)
+	}
+
+	page, err := pageRequest(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.ListAuditEvents(ctx, query, page)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*contentPB.AuditEvent, len(result.Events))
+	for i := range result.Events {
+		events[i] = convertToProtoAuditEvent(&result.Events[i])
+	}
+
+	return &contentPB.ListAuditEventsResponse{
+		Events:        events,
+		NextPageToken: encodePageToken(result.Next),
+	}, nil
+}
+
+func convertToProtoAuditEvent(event *models.AuditEvent) *contentPB.AuditEvent {
+	return &contentPB.AuditEvent{
+		EventID:    event.ID.Hex(),
+		ActorID:    event.ActorID,
+		ActorRole:  event.ActorRole,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		Reason:     event.Reason,
+		Before:     snapshotJSON(event.Before),
+		After:      snapshotJSON(event.After),
+		Request: &contentPB.RequestMetadata{
+			Method:    event.Request.Method,
+			PeerAddr:  event.Request.PeerAddr,
+			UserAgent: event.Request.UserAgent,
+			RequestID: event.Request.RequestID,
+		},
+		CreatedAt: event.CreatedAt.Unix(),
+	}
+}
+
+// snapshotJSON renders a snapshot as relaxed Extended JSON.
+func snapshotJSON(raw bson.Raw) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	data, err := bson.MarshalExtJSON(raw, false, false)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
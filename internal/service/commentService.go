@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/liju-github/ContentService/internal/auth"
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	"github.com/liju-github/ContentService/internal/policy"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+	contentPB "github.com/liju-github/ContentService/proto/content"
+)
+
+const (
+	minCommentLength = 2
+	maxCommentLength = 600
+)
+
+func (s *ContentService) PostComment(ctx context.Context, req *contentPB.PostCommentRequest) (*contentPB.PostCommentResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"question_id", req.QuestionID}); err != nil {
+		return nil, err
+	}
+
+	body, err := validateCommentBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := newComment(req.QuestionID, req.AnswerID)
+	if err != nil {
+		return nil, err
+	}
+	comment.UserID = userID
+	comment.Body = body
+
+	if req.ParentID != "" {
+		parentID, err := mongodb.ParseID("parent_id", req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		comment.ParentID = &parentID
+	}
+
+	if err := s.repo.PostComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return &contentPB.PostCommentResponse{
+		Success: true,
+		Message: "Comment posted successfully",
+		Comment: convertToProtoComment(comment),
+	}, nil
+}
+
+func (s *ContentService) EditComment(ctx context.Context, req *contentPB.EditCommentRequest) (*contentPB.EditCommentResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"comment_id", req.CommentID}); err != nil {
+		return nil, err
+	}
+
+	body, err := validateCommentBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyCommentOwner(ctx, req.CommentID, userID); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.repo.EditComment(ctx, req.CommentID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contentPB.EditCommentResponse{
+		Success: true,
+		Message: "Comment edited successfully",
+		Comment: convertToProtoComment(comment),
+	}, nil
+}
+
+func (s *ContentService) DeleteComment(ctx context.Context, req *contentPB.DeleteCommentRequest) (*contentPB.DeleteCommentResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"comment_id", req.CommentID}); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.repo.GetCommentByID(ctx, req.CommentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Moderators may delete any comment
+	if comment.UserID != userID && !policy.HasRole(ctx, policy.RoleModerator) {
+		return nil, errs.PermissionDeniedf("only the comment owner can modify the comment")
+	}
+
+	if err := s.repo.DeleteComment(ctx, req.CommentID); err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, models.AuditEvent{
+		Action:     models.AuditCommentDelete,
+		TargetType: models.TargetComment,
+		TargetID:   req.CommentID,
+		Before:     snapshot(comment),
+	})
+
+	return &contentPB.DeleteCommentResponse{
+		Success: true,
+		Message: "Comment deleted successfully",
+	}, nil
+}
+
+func (s *ContentService) ListComments(ctx context.Context, req *contentPB.ListCommentsRequest) (*contentPB.ListCommentsResponse, error) {
+	if err := required(field{"question_id", req.QuestionID}); err != nil {
+		return nil, err
+	}
+
+	target, err := newComment(req.QuestionID, req.AnswerID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := pageRequest(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.ListComments(ctx, target.TargetType, target.TargetID.Hex(), page)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*contentPB.Comment, len(result.Comments))
+	for i := range result.Comments {
+		comments[i] = convertToProtoComment(&result.Comments[i])
+	}
+
+	return &contentPB.ListCommentsResponse{
+		Comments:      comments,
+		NextPageToken: encodePageToken(result.Next),
+	}, nil
+}
+
+func (s *ContentService) FlagComment(ctx context.Context, req *contentPB.FlagCommentRequest) (*contentPB.FlagCommentResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"comment_id", req.CommentID}); err != nil {
+		return nil, err
+	}
+
+	flag, err := s.newFlag(ctx, userID, req.Category, "", req.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.FlagComment(ctx, req.CommentID, flag, s.autoHide)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contentPB.FlagCommentResponse{
+		Success: true,
+		Message: "Comment flagged successfully",
+	}, nil
+}
+
+func (s *ContentService) verifyCommentOwner(ctx context.Context, commentID, userID string) error {
+	comment, err := s.repo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != userID {
+		return errs.PermissionDeniedf("only the comment owner can modify the comment")
+	}
+
+	return nil
+}
+
+// newComment returns a comment targeting the question, or one of its answers
+// when answerID is set.
+func newComment(questionID, answerID string) (*models.Comment, error) {
+	qID, err := mongodb.ParseID("question_id", questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &models.Comment{
+		TargetType: models.TargetQuestion,
+		TargetID:   qID,
+		QuestionID: qID,
+	}
+
+	if answerID != "" {
+		aID, err := mongodb.ParseID("answer_id", answerID)
+		if err != nil {
+			return nil, err
+		}
+		comment.TargetType = models.TargetAnswer
+		comment.TargetID = aID
+	}
+
+	return comment, nil
+}
+
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if len(body) < minCommentLength {
+		return "", errs.InvalidField("body", fmt.Sprintf("must be at least %d characters long", minCommentLength))
+	}
+
+	if len(body) > maxCommentLength {
+		return "", errs.InvalidField("body", fmt.Sprintf("must be at most %d characters long", maxCommentLength))
+	}
+
+	return body, nil
+}
+
+func convertToProtoComment(comment *models.Comment) *contentPB.Comment {
+	// Only the position of a deleted comment in its thread is shown
+	if comment.DeletedAt != nil {
+		comment = &models.Comment{
+			ID:         comment.ID,
+			TargetType: comment.TargetType,
+			TargetID:   comment.TargetID,
+			QuestionID: comment.QuestionID,
+			ParentID:   comment.ParentID,
+			Ancestors:  comment.Ancestors,
+			Body:       models.DeletedCommentBody,
+			CreatedAt:  comment.CreatedAt,
+			UpdatedAt:  comment.UpdatedAt,
+		}
+	}
+
+	pbComment := &contentPB.Comment{
+		CommentID:  comment.ID.Hex(),
+		QuestionID: comment.QuestionID.Hex(),
+		UserID:     comment.UserID,
+		Body:       comment.Body,
+		IsFlagged:  comment.IsFlagged,
+		Depth:      int32(len(comment.Ancestors)),
+		CreatedAt:  comment.CreatedAt.Unix(),
+		UpdatedAt:  comment.UpdatedAt.Unix(),
+		IsHidden:   comment.IsHidden,
+	}
+
+	if comment.TargetType == models.TargetAnswer {
+		pbComment.AnswerID = comment.TargetID.Hex()
+	}
+
+	if comment.ParentID != nil {
+		pbComment.ParentID = comment.ParentID.Hex()
+	}
+
+	return pbComment
+}
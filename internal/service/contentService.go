@@ -2,55 +2,150 @@ package service
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/liju-github/ContentService/internal/auth"
+	"github.com/liju-github/ContentService/internal/errs"
 	"github.com/liju-github/ContentService/internal/models"
+	"github.com/liju-github/ContentService/internal/policy"
 	mongodb "github.com/liju-github/ContentService/internal/repository"
 	contentPB "github.com/liju-github/ContentService/proto/content"
 )
 
+const maxTagLength = 10
+
+// Limits bounds the size of questions and answers.
+type Limits models.Limits
+
+// DefaultLimits are the limits used unless WithLimits sets others.
+var DefaultLimits = Limits(models.DefaultLimits)
+
+// WriteMethods are the RPCs that change anything: content, votes, flags and
+// their resolution, the tag catalog and follows. Banned users may not call
+// them, whatever their role.
+var WriteMethods = []string{
+	contentPB.ContentService_PostQuestion_FullMethodName,
+	contentPB.ContentService_EditQuestion_FullMethodName,
+	contentPB.ContentService_DeleteQuestion_FullMethodName,
+	contentPB.ContentService_RestoreQuestion_FullMethodName,
+	contentPB.ContentService_PostAnswerByQuestionID_FullMethodName,
+	contentPB.ContentService_EditAnswer_FullMethodName,
+	contentPB.ContentService_DeleteAnswerByAnswerID_FullMethodName,
+	contentPB.ContentService_RestoreAnswer_FullMethodName,
+	contentPB.ContentService_UpvoteAnswerByAnswerID_FullMethodName,
+	contentPB.ContentService_DownvoteAnswerByAnswerID_FullMethodName,
+	contentPB.ContentService_AcceptAnswer_FullMethodName,
+	contentPB.ContentService_UnacceptAnswer_FullMethodName,
+	contentPB.ContentService_MarkQuestionAsAnswered_FullMethodName,
+	contentPB.ContentService_FlagQuestion_FullMethodName,
+	contentPB.ContentService_FlagAnswer_FullMethodName,
+	contentPB.ContentService_PostComment_FullMethodName,
+	contentPB.ContentService_EditComment_FullMethodName,
+	contentPB.ContentService_DeleteComment_FullMethodName,
+	contentPB.ContentService_FlagComment_FullMethodName,
+	contentPB.ContentService_ResolveFlag_FullMethodName,
+	contentPB.ContentService_AddTag_FullMethodName,
+	contentPB.ContentService_UpdateTag_FullMethodName,
+	contentPB.ContentService_RemoveTag_FullMethodName,
+	contentPB.ContentService_Follow_FullMethodName,
+	contentPB.ContentService_Unfollow_FullMethodName,
+}
+
+// MethodRoles lists the RPCs that need more than an anonymous caller.
+// Handlers of the other RPCs check for an authenticated caller themselves
+// where they need one.
+var MethodRoles = map[string]policy.Role{
+	contentPB.ContentService_GetFlaggedQuestions_FullMethodName:    policy.RoleModerator,
+	contentPB.ContentService_GetFlaggedAnswers_FullMethodName:      policy.RoleModerator,
+	contentPB.ContentService_MarkQuestionAsAnswered_FullMethodName: policy.RoleModerator,
+	contentPB.ContentService_ListModerationQueue_FullMethodName:    policy.RoleModerator,
+	contentPB.ContentService_ResolveFlag_FullMethodName:            policy.RoleModerator,
+	contentPB.ContentService_CountFlagsByReason_FullMethodName:     policy.RoleModerator,
+	contentPB.ContentService_ListAuditEvents_FullMethodName:        policy.RoleAdmin,
+	contentPB.ContentService_AddTag_FullMethodName:                 policy.RoleAdmin,
+	contentPB.ContentService_UpdateTag_FullMethodName:              policy.RoleAdmin,
+	contentPB.ContentService_RemoveTag_FullMethodName:              policy.RoleAdmin,
+}
+
 type ContentService struct {
 	contentPB.UnimplementedContentServiceServer
-	repo mongodb.Repository
+	repo      mongodb.Repository
+	tagPolicy TagPolicy
+	autoHide  models.AutoHide
+	limits    Limits
+}
+
+// Option configures optional ContentService behaviour.
+type Option func(*ContentService)
+
+// WithTagPolicy sets how PostQuestion handles tags missing from the catalog.
+func WithTagPolicy(policy TagPolicy) Option {
+	return func(s *ContentService) {
+		s.tagPolicy = policy
+	}
 }
 
-func NewContentService(repo mongodb.Repository) *ContentService {
-	return &ContentService{
-		repo: repo,
+// WithAutoHide sets when flagged posts are hidden until a moderator reviews
+// them.
+func WithAutoHide(hide models.AutoHide) Option {
+	return func(s *ContentService) {
+		s.autoHide = hide
 	}
 }
 
+// WithLimits sets how long questions and answers may be and how many tags a
+// question may have.
+func WithLimits(limits Limits) Option {
+	return func(s *ContentService) {
+		s.limits = limits
+	}
+}
+
+func NewContentService(repo mongodb.Repository, opts ...Option) *ContentService {
+	s := &ContentService{
+		repo:      repo,
+		tagPolicy: TagPolicyAllow,
+		autoHide:  DefaultAutoHide,
+		limits:    DefaultLimits,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
 func (s *ContentService) PostQuestion(ctx context.Context, req *contentPB.PostQuestionRequest) (*contentPB.PostQuestionResponse, error) {
-	if err := validatePostQuestion(req); err != nil {
-		return &contentPB.PostQuestionResponse{
-			Success: false,
-			Message: err.Error(),
-		}, err
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePostQuestion(req, s.limits); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.resolveTags(ctx, sanitizeTags(req.Tags))
+	if err != nil {
+		return nil, err
 	}
 
 	question := &models.Question{
-		UserID:     req.UserID,
+		UserID:     userID,
 		Question:   strings.TrimSpace(req.Question),
 		Details:    strings.TrimSpace(req.Details),
-		Tags:       sanitizeTags(req.Tags),
+		Tags:       tags,
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
 		IsAnswered: false,
 		IsFlagged:  false,
-		Answers:    []models.Answer{},
 		Flags:      []models.Flag{},
 	}
 
-	err := s.repo.PostQuestion(ctx, question)
+	err = s.repo.PostQuestion(ctx, question)
 	if err != nil {
-		return &contentPB.PostQuestionResponse{
-			Success: false,
-			Message: "Failed to create question: " + err.Error(),
-		}, err
+		return nil, err
 	}
 
 	return &contentPB.PostQuestionResponse{
@@ -60,53 +155,71 @@ func (s *ContentService) PostQuestion(ctx context.Context, req *contentPB.PostQu
 }
 
 func (s *ContentService) GetQuestionsByUserID(ctx context.Context, req *contentPB.GetQuestionsByUserIDRequest) (*contentPB.GetQuestionsByUserIDResponse, error) {
-	if req.UserID == "" {
-		return nil, errors.New("user_id is required")
+	if err := required(field{"user_id", req.UserID}); err != nil {
+		return nil, err
+	}
+
+	page, err := pageRequest(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
 	}
 
-	questions, err := s.repo.GetQuestionsByUserID(ctx, req.UserID)
+	result, err := s.repo.GetQuestionsByUserID(ctx, req.UserID, page)
 	if err != nil {
 		return nil, err
 	}
 
 	return &contentPB.GetQuestionsByUserIDResponse{
-		Questions: convertToProtoQuestions(questions),
+		Questions:     convertToProtoQuestions(result.Questions),
+		NextPageToken: encodePageToken(result.Next),
 	}, nil
 }
 
 func (s *ContentService) GetQuestionsByTags(ctx context.Context, req *contentPB.GetQuestionsByTagsRequest) (*contentPB.GetQuestionsByTagsResponse, error) {
 	if len(req.Tags) == 0 {
-		return nil, errors.New("at least one tag is required")
+		return nil, errs.InvalidField("tags", "at least one tag is required")
+	}
+
+	page, err := pageRequest(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
 	}
 
-	questions, err := s.repo.GetQuestionsByTags(ctx, sanitizeTags(req.Tags))
+	result, err := s.repo.GetQuestionsByTags(ctx, sanitizeTags(req.Tags), page)
 	if err != nil {
 		return nil, err
 	}
 
 	return &contentPB.GetQuestionsByTagsResponse{
-		Questions: convertToProtoQuestions(questions),
+		Questions:     convertToProtoQuestions(result.Questions),
+		NextPageToken: encodePageToken(result.Next),
 	}, nil
 }
 
 func (s *ContentService) GetQuestionsByWord(ctx context.Context, req *contentPB.GetQuestionsByWordRequest) (*contentPB.GetQuestionsByWordResponse, error) {
-	if req.SearchWord == "" {
-		return nil, errors.New("search word is required")
+	if err := required(field{"search_word", req.SearchWord}); err != nil {
+		return nil, err
+	}
+
+	page, err := pageRequest(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
 	}
 
-	questions, err := s.repo.GetQuestionsByWord(ctx, strings.TrimSpace(req.SearchWord))
+	result, err := s.repo.GetQuestionsByWord(ctx, strings.TrimSpace(req.SearchWord), page)
 	if err != nil {
 		return nil, err
 	}
 
 	return &contentPB.GetQuestionsByWordResponse{
-		Questions: convertToProtoQuestions(questions),
+		Questions:     convertToProtoQuestions(result.Questions),
+		NextPageToken: encodePageToken(result.Next),
 	}, nil
 }
 
 func (s *ContentService) GetQuestionByID(ctx context.Context, req *contentPB.GetQuestionByIDRequest) (*contentPB.GetQuestionByIDResponse, error) {
-	if req.QuestionID == "" {
-		return nil, errors.New("question_id is required")
+	if err := required(field{"question_id", req.QuestionID}); err != nil {
+		return nil, err
 	}
 
 	question, err := s.repo.GetQuestionByID(ctx, req.QuestionID)
@@ -114,81 +227,128 @@ func (s *ContentService) GetQuestionByID(ctx context.Context, req *contentPB.Get
 		return nil, err
 	}
 
-	pbAnswers := make([]*contentPB.Answer, len(question.Answers))
-	for i, answer := range question.Answers {
-		pbAnswers[i] = &contentPB.Answer{
-			Id:         answer.ID.Hex(),
-			QuestionId: question.ID.Hex(),
-			UserId:     answer.UserID,
-			AnswerText: answer.Answer,
-			Upvotes:    int32(answer.Upvotes),
-			Downvotes:  int32(answer.Downvotes),
-			IsFlagged:  answer.IsFlagged,
-			CreatedAt:  answer.CreatedAt.Unix(),
-			UpdatedAt:  answer.UpdatedAt.Unix(),
-		}
+	answers, err := s.repo.GetAnswersByQuestionID(ctx, req.QuestionID)
+	if err != nil {
+		return nil, err
 	}
 
-	pbQuestion := &contentPB.Question{
-		QuestionID: question.ID.Hex(),
-		Question:   question.Question,
-		UserID:     question.UserID,
-		CreatedAt:  question.CreatedAt.Unix(),
-		Tags:       question.Tags,
-		IsAnswered: question.IsAnswered,
-		Details:    question.Details,
+	// The accepted answer is listed first, the rest keep their order
+	pbAnswers := convertToProtoAnswers(answers)
+	if question.AcceptedAnswerID != nil {
+		accepted := question.AcceptedAnswerID.Hex()
+		for i, answer := range pbAnswers {
+			if answer.Id == accepted {
+				answer.IsAccepted = true
+				copy(pbAnswers[1:i+1], pbAnswers[:i])
+				pbAnswers[0] = answer
+				break
+			}
+		}
 	}
 
 	return &contentPB.GetQuestionByIDResponse{
-		Question: pbQuestion,
+		Question: convertToProtoQuestion(question),
 		Answers:  pbAnswers,
 	}, nil
 }
 
 func (s *ContentService) DeleteQuestion(ctx context.Context, req *contentPB.DeleteQuestionRequest) (*contentPB.DeleteQuestionResponse, error) {
-	if req.QuestionID == "" || req.UserID == "" {
-		return &contentPB.DeleteQuestionResponse{
-			Success: false,
-			Message: "question_id and user_id are required",
-		}, errors.New("question_id and user_id are required")
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"question_id", req.QuestionID}); err != nil {
+		return nil, err
 	}
 
 	// Verify user owns the question
-	questionOwnerID, err := s.repo.GetUserIDFromQuestionID(ctx, req.QuestionID)
+	question, err := s.repo.GetQuestionByID(ctx, req.QuestionID)
 	if err != nil {
-		return &contentPB.DeleteQuestionResponse{
-			Success: false,
-			Message: "Failed to verify question ownership",
-		}, err
+		return nil, err
 	}
 
-	if questionOwnerID != req.UserID {
-		return &contentPB.DeleteQuestionResponse{
-			Success: false,
-			Message: "Unauthorized: only question owner can delete the question",
-		}, errors.New("unauthorized deletion attempt")
+	// Moderators removing someone else's question have to say why
+	reason := strings.TrimSpace(req.Reason)
+	if question.UserID != userID {
+		if !policy.HasRole(ctx, policy.RoleModerator) {
+			return nil, errs.PermissionDeniedf("only the question owner or a moderator can delete the question")
+		}
+		if reason == "" {
+			return nil, errs.InvalidField("reason", "is required when deleting another user's question")
+		}
 	}
 
-	err = s.repo.DeleteQuestion(ctx, req.QuestionID)
+	err = s.repo.DeleteQuestion(ctx, req.QuestionID, userID, reason)
 	if err != nil {
-		return &contentPB.DeleteQuestionResponse{
-			Success: false,
-			Message: "Failed to delete question: " + err.Error(),
-		}, err
+		return nil, err
 	}
 
+	s.audit(ctx, models.AuditEvent{
+		Action:     models.AuditQuestionDelete,
+		TargetType: models.TargetQuestion,
+		TargetID:   req.QuestionID,
+		Reason:     reason,
+		Before:     snapshot(question),
+	})
+
 	return &contentPB.DeleteQuestionResponse{
 		Success: true,
 		Message: "Question deleted successfully",
 	}, nil
 }
 
+// RestoreQuestion undeletes a question along with the answers deleted with
+// it. Owners can restore questions they deleted themselves, while questions
+// removed by a moderator need a moderator.
+func (s *ContentService) RestoreQuestion(ctx context.Context, req *contentPB.RestoreQuestionRequest) (*contentPB.RestoreQuestionResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"question_id", req.QuestionID}); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.repo.GetDeletedQuestion(ctx, req.QuestionID)
+	if err != nil {
+		return nil, err
+	}
+
+	selfDeleted := deleted.UserID == userID && deleted.DeletedBy == userID
+	if !selfDeleted && !policy.HasRole(ctx, policy.RoleModerator) {
+		return nil, errs.PermissionDeniedf("only a moderator can restore this question")
+	}
+
+	question, err := s.repo.RestoreQuestion(ctx, req.QuestionID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, models.AuditEvent{
+		Action:     models.AuditQuestionRestore,
+		TargetType: models.TargetQuestion,
+		TargetID:   req.QuestionID,
+		Before:     snapshot(deleted),
+		After:      snapshot(question),
+	})
+
+	return &contentPB.RestoreQuestionResponse{
+		Success:  true,
+		Message:  "Question restored successfully",
+		Question: convertToProtoQuestion(question),
+	}, nil
+}
+
 func (s *ContentService) PostAnswerByQuestionID(ctx context.Context, req *contentPB.PostAnswerByQuestionIDRequest) (*contentPB.PostAnswerByQuestionIDResponse, error) {
-	if err := validatePostAnswer(req); err != nil {
-		return &contentPB.PostAnswerByQuestionIDResponse{
-			Success: false,
-			Message: err.Error(),
-		}, err
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePostAnswer(req, s.limits); err != nil {
+		return nil, err
 	}
 
 	// // Check if user is trying to answer their own question
@@ -200,7 +360,7 @@ func (s *ContentService) PostAnswerByQuestionID(ctx context.Context, req *conten
 	//     }, err
 	// }
 
-	// if questionOwnerID == req.UserID {
+	// if questionOwnerID == userID {
 	//     return &contentPB.PostAnswerByQuestionIDResponse{
 	//         Success: false,
 	//         Message: "Cannot answer your own question",
@@ -208,7 +368,7 @@ func (s *ContentService) PostAnswerByQuestionID(ctx context.Context, req *conten
 	// }
 
 	answer := &models.Answer{
-		UserID:    req.UserID,
+		UserID:    userID,
 		Answer:    strings.TrimSpace(req.Answer),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -216,14 +376,12 @@ func (s *ContentService) PostAnswerByQuestionID(ctx context.Context, req *conten
 		Downvotes: 0,
 		IsFlagged: false,
 		Flags:     []models.Flag{},
+		Vote:      []models.Vote{},
 	}
 
-	err := s.repo.PostAnswer(ctx, req.QuestionID, answer)
+	err = s.repo.PostAnswer(ctx, req.QuestionID, answer)
 	if err != nil {
-		return &contentPB.PostAnswerByQuestionIDResponse{
-			Success: false,
-			Message: "Failed to post answer: " + err.Error(),
-		}, err
+		return nil, err
 	}
 
 	return &contentPB.PostAnswerByQuestionIDResponse{
@@ -233,41 +391,159 @@ func (s *ContentService) PostAnswerByQuestionID(ctx context.Context, req *conten
 }
 
 func (s *ContentService) DeleteAnswerByAnswerID(ctx context.Context, req *contentPB.DeleteAnswerByAnswerIDRequest) (*contentPB.DeleteAnswerByAnswerIDResponse, error) {
-	if req.AnswerID == "" || req.QuestionID == "" {
-		return &contentPB.DeleteAnswerByAnswerIDResponse{
-			Success: false,
-			Message: "answer_id and question_id are required",
-		}, errors.New("answer_id and question_id are required")
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"answer_id", req.AnswerID}, field{"question_id", req.QuestionID}); err != nil {
+		return nil, err
+	}
+
+	// Verify user owns the answer
+	answer, err := s.repo.GetAnswerByID(ctx, req.QuestionID, req.AnswerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Moderators removing someone else's answer have to say why
+	reason := strings.TrimSpace(req.Reason)
+	if answer.UserID != userID {
+		if !policy.HasRole(ctx, policy.RoleModerator) {
+			return nil, errs.PermissionDeniedf("only the answer owner or a moderator can delete the answer")
+		}
+		if reason == "" {
+			return nil, errs.InvalidField("reason", "is required when deleting another user's answer")
+		}
 	}
 
-	err := s.repo.DeleteAnswer(ctx, req.QuestionID, req.AnswerID)
+	err = s.repo.DeleteAnswer(ctx, req.QuestionID, req.AnswerID, userID, reason)
 	if err != nil {
-		return &contentPB.DeleteAnswerByAnswerIDResponse{
-			Success: false,
-			Message: "Failed to delete answer: " + err.Error(),
-		}, err
+		return nil, err
 	}
 
+	s.audit(ctx, models.AuditEvent{
+		Action:     models.AuditAnswerDelete,
+		TargetType: models.TargetAnswer,
+		TargetID:   req.AnswerID,
+		Reason:     reason,
+		Before:     snapshot(answer),
+	})
+
 	return &contentPB.DeleteAnswerByAnswerIDResponse{
 		Success: true,
 		Message: "Answer deleted successfully",
 	}, nil
 }
 
+// RestoreAnswer undeletes an answer. Owners can restore answers they deleted
+// themselves, while answers removed by a moderator need a moderator.
+func (s *ContentService) RestoreAnswer(ctx context.Context, req *contentPB.RestoreAnswerRequest) (*contentPB.RestoreAnswerResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"question_id", req.QuestionID}, field{"answer_id", req.AnswerID}); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.repo.GetDeletedAnswer(ctx, req.QuestionID, req.AnswerID)
+	if err != nil {
+		return nil, err
+	}
+
+	selfDeleted := deleted.UserID == userID && deleted.DeletedBy == userID
+	if !selfDeleted && !policy.HasRole(ctx, policy.RoleModerator) {
+		return nil, errs.PermissionDeniedf("only a moderator can restore this answer")
+	}
+
+	answer, err := s.repo.RestoreAnswer(ctx, req.QuestionID, req.AnswerID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, models.AuditEvent{
+		Action:     models.AuditAnswerRestore,
+		TargetType: models.TargetAnswer,
+		TargetID:   req.AnswerID,
+		Before:     snapshot(deleted),
+		After:      snapshot(answer),
+	})
+
+	return &contentPB.RestoreAnswerResponse{
+		Success: true,
+		Message: "Answer restored successfully",
+		Answer:  convertToProtoAnswer(answer),
+	}, nil
+}
+
+func (s *ContentService) UpvoteAnswerByAnswerID(ctx context.Context, req *contentPB.UpvoteAnswerByAnswerIDRequest) (*contentPB.UpvoteAnswerByAnswerIDResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"question_id", req.QuestionID}, field{"answer_id", req.AnswerID}); err != nil {
+		return nil, err
+	}
+
+	result, err := s.voteOnAnswer(ctx, req.QuestionID, req.AnswerID, userID, models.VoteTypeUpvote)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contentPB.UpvoteAnswerByAnswerIDResponse{
+		Success:   true,
+		Message:   result.message,
+		Upvotes:   result.upvotes,
+		Downvotes: result.downvotes,
+		UserVote:  result.userVote,
+	}, nil
+}
+
+func (s *ContentService) DownvoteAnswerByAnswerID(ctx context.Context, req *contentPB.DownvoteAnswerByAnswerIDRequest) (*contentPB.DownvoteAnswerByAnswerIDResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"question_id", req.QuestionID}, field{"answer_id", req.AnswerID}); err != nil {
+		return nil, err
+	}
+
+	result, err := s.voteOnAnswer(ctx, req.QuestionID, req.AnswerID, userID, models.VoteTypeDownvote)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contentPB.DownvoteAnswerByAnswerIDResponse{
+		Success:   true,
+		Message:   result.message,
+		Upvotes:   result.upvotes,
+		Downvotes: result.downvotes,
+		UserVote:  result.userVote,
+	}, nil
+}
+
 func (s *ContentService) FlagQuestion(ctx context.Context, req *contentPB.FlagQuestionRequest) (*contentPB.FlagQuestionResponse, error) {
-	if req.QuestionID == "" || req.UserID == "" || req.Reason == "" {
-		return &contentPB.FlagQuestionResponse{
-			Success: false,
-			Message: "question_id, user_id, and reason are required",
-		}, errors.New("question_id, user_id, and reason are required")
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"question_id", req.QuestionID}); err != nil {
+		return nil, err
+	}
+
+	flag, err := s.newFlag(ctx, userID, req.Category, req.Reason, req.Details)
+	if err != nil {
+		return nil, err
 	}
 
-	err := s.repo.FlagQuestion(ctx, req.QuestionID, req.UserID, req.Reason)
+	err = s.repo.FlagQuestion(ctx, req.QuestionID, flag, s.autoHide)
 	if err != nil {
-		return &contentPB.FlagQuestionResponse{
-			Success: false,
-			Message: "Failed to flag question: " + err.Error(),
-		}, err
+		return nil, err
 	}
 
 	return &contentPB.FlagQuestionResponse{
@@ -277,19 +553,23 @@ func (s *ContentService) FlagQuestion(ctx context.Context, req *contentPB.FlagQu
 }
 
 func (s *ContentService) FlagAnswer(ctx context.Context, req *contentPB.FlagAnswerRequest) (*contentPB.FlagAnswerResponse, error) {
-	if req.AnswerID == "" || req.UserID == "" || req.Reason == "" || req.QuestionID == "" {
-		return &contentPB.FlagAnswerResponse{
-			Success: false,
-			Message: "answer_id, question_id, user_id, and reason are required",
-		}, errors.New("answer_id, question_id, user_id, and reason are required")
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	err := s.repo.FlagAnswer(ctx, req.QuestionID, req.AnswerID, req.UserID, req.Reason)
+	if err := required(field{"answer_id", req.AnswerID}, field{"question_id", req.QuestionID}); err != nil {
+		return nil, err
+	}
+
+	flag, err := s.newFlag(ctx, userID, req.Category, req.Reason, req.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.FlagAnswer(ctx, req.QuestionID, req.AnswerID, flag, s.autoHide)
 	if err != nil {
-		return &contentPB.FlagAnswerResponse{
-			Success: false,
-			Message: "Failed to flag answer: " + err.Error(),
-		}, err
+		return nil, err
 	}
 
 	return &contentPB.FlagAnswerResponse{
@@ -298,134 +578,278 @@ func (s *ContentService) FlagAnswer(ctx context.Context, req *contentPB.FlagAnsw
 	}, nil
 }
 
+// MarkQuestionAsAnswered is deprecated and changes nothing. It only confirms
+// that the question has an accepted answer; callers should use AcceptAnswer.
 func (s *ContentService) MarkQuestionAsAnswered(ctx context.Context, req *contentPB.MarkQuestionAsAnsweredRequest) (*contentPB.MarkQuestionAsAnsweredResponse, error) {
-	if req.QuestionID == "" {
-		return &contentPB.MarkQuestionAsAnsweredResponse{
-			Success: false,
-			Message: "question_id is required",
-		}, errors.New("question_id is required")
+	if err := required(field{"question_id", req.QuestionID}); err != nil {
+		return nil, err
 	}
 
 	err := s.repo.MarkQuestionAsAnswered(ctx, req.QuestionID)
 	if err != nil {
-		return &contentPB.MarkQuestionAsAnsweredResponse{
-			Success: false,
-			Message: "Failed to mark question as answered: " + err.Error(),
-		}, err
+		return nil, err
 	}
 
 	return &contentPB.MarkQuestionAsAnsweredResponse{
 		Success: true,
-		Message: "Question marked as answered successfully",
+		Message: "Question has an accepted answer",
 	}, nil
 }
 
-func (s *ContentService) GetUserFeed(ctx context.Context, req *contentPB.GetUserFeedRequest) (*contentPB.GetUserFeedResponse, error) {
-	questions, err := s.repo.GetUserFeed(ctx, req.UserID)
+func (s *ContentService) AcceptAnswer(ctx context.Context, req *contentPB.AcceptAnswerRequest) (*contentPB.AcceptAnswerResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"question_id", req.QuestionID}, field{"answer_id", req.AnswerID}); err != nil {
+		return nil, err
+	}
+
+	// Verify user owns the question
+	before, err := s.repo.GetQuestionByID(ctx, req.QuestionID)
 	if err != nil {
 		return nil, err
 	}
 
-	return &contentPB.GetUserFeedResponse{
-		Questions: convertToProtoQuestions(questions),
+	if before.UserID != userID {
+		return nil, errs.PermissionDeniedf("only the question owner can accept an answer")
+	}
+
+	if err := s.repo.AcceptAnswer(ctx, req.QuestionID, req.AnswerID); err != nil {
+		return nil, err
+	}
+
+	s.auditQuestionChange(ctx, models.AuditAnswerAccept, before)
+
+	return &contentPB.AcceptAnswerResponse{
+		Success: true,
+		Message: "Answer accepted successfully",
 	}, nil
 }
 
-func (s *ContentService) GetFlaggedQuestions(ctx context.Context,req *contentPB.GetFlaggedQuestionsRequest) (*contentPB.GetFlaggedQuestionsResponse, error) {
+func (s *ContentService) UnacceptAnswer(ctx context.Context, req *contentPB.UnacceptAnswerRequest) (*contentPB.UnacceptAnswerResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"question_id", req.QuestionID}); err != nil {
+		return nil, err
+	}
 
-	questions, totalCount, err := s.repo.GetFlaggedQuestions(ctx)
+	// Verify user owns the question
+	before, err := s.repo.GetQuestionByID(ctx, req.QuestionID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get flagged questions: %v", err)
+		return nil, err
 	}
 
-	return &contentPB.GetFlaggedQuestionsResponse{
-		FlaggedQuestions:      convertToProtoQuestions(questions),
-		TotalFlaggedQuestions: totalCount,
+	if before.UserID != userID {
+		return nil, errs.PermissionDeniedf("only the question owner can unaccept an answer")
+	}
+
+	if err := s.repo.UnacceptAnswer(ctx, req.QuestionID); err != nil {
+		return nil, err
+	}
+
+	s.auditQuestionChange(ctx, models.AuditAnswerUnaccept, before)
+
+	return &contentPB.UnacceptAnswerResponse{
+		Success: true,
+		Message: "Answer unaccepted successfully",
 	}, nil
 }
 
-func (s *ContentService) GetFlaggedAnswers(ctx context.Context,req *contentPB.GetFlaggedAnswersRequest) (*contentPB.GetFlaggedAnswersResponse, error) {
+func (s *ContentService) GetFlaggedQuestions(ctx context.Context, req *contentPB.GetFlaggedQuestionsRequest) (*contentPB.GetFlaggedQuestionsResponse, error) {
+	page, err := pageRequest(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
 
-	answers, totalCount, err := s.repo.GetFlaggedAnswers(ctx)
+	result, err := s.repo.GetFlaggedQuestions(ctx, page)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get flagged answers: %v", err)
+		return nil, err
 	}
 
-	log.Println("answers and totalcount",answers,totalCount)
+	return &contentPB.GetFlaggedQuestionsResponse{
+		FlaggedQuestions:      convertToProtoQuestions(result.Questions),
+		TotalFlaggedQuestions: int32(result.Total),
+		NextPageToken:         encodePageToken(result.Next),
+	}, nil
+}
+
+func (s *ContentService) GetFlaggedAnswers(ctx context.Context, req *contentPB.GetFlaggedAnswersRequest) (*contentPB.GetFlaggedAnswersResponse, error) {
+	page, err := pageRequest(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
 
-	protoAnswers := make([]*contentPB.Answer, len(answers))
-	for i, answer := range answers {
-		protoAnswers[i] = &contentPB.Answer{
-			Id:         answer.ID.Hex(),
-			UserId:     answer.UserID,
-			AnswerText: answer.Answer,
-			Upvotes:    int32(answer.Upvotes),
-			Downvotes:  int32(answer.Downvotes),
-			IsFlagged:  answer.IsFlagged,
-			CreatedAt:  answer.CreatedAt.Unix(),
-			UpdatedAt:  answer.UpdatedAt.Unix(),
-		}
+	result, err := s.repo.GetFlaggedAnswers(ctx, page)
+	if err != nil {
+		return nil, err
 	}
 
 	return &contentPB.GetFlaggedAnswersResponse{
-		FlaggedAnswers:      protoAnswers,
-		TotalFlaggedAnswers: totalCount,
+		FlaggedAnswers:      convertToProtoAnswers(result.Answers),
+		TotalFlaggedAnswers: int32(result.Total),
+		NextPageToken:       encodePageToken(result.Next),
 	}, nil
 }
 
 // Helper functions
 
-func validatePostQuestion(req *contentPB.PostQuestionRequest) error {
-	if req.Question == "" || req.UserID == "" {
-		return errors.New("question and user_id are required")
+func validatePostQuestion(req *contentPB.PostQuestionRequest, limits Limits) error {
+	if err := required(field{"question", req.Question}); err != nil {
+		return err
+	}
+
+	return limits.checkQuestion(req.Question, req.Details, req.Tags)
+}
+
+func validatePostAnswer(req *contentPB.PostAnswerByQuestionIDRequest, limits Limits) error {
+	if err := required(field{"question_id", req.QuestionID}, field{"answer", req.Answer}); err != nil {
+		return err
+	}
+
+	return limits.checkAnswer(req.Answer)
+}
+
+func (l Limits) checkQuestion(question, details string, tags []string) error {
+	if len(question) < l.MinQuestionLength {
+		return errs.InvalidField("question", fmt.Sprintf("must be at least %d characters long", l.MinQuestionLength))
 	}
 
-	if len(req.Question) < 10 {
-		return errors.New("question must be at least 10 characters long")
+	if len(details) > l.MaxDetailsLength {
+		return errs.InvalidField("details", fmt.Sprintf("must be at most %d characters long", l.MaxDetailsLength))
 	}
 
-	if len(req.Tags) > 5 {
-		return errors.New("maximum 5 tags allowed")
+	if len(tags) > l.MaxTags {
+		return errs.InvalidField("tags", fmt.Sprintf("maximum %d tags allowed", l.MaxTags))
 	}
 
 	return nil
 }
 
-func validatePostAnswer(req *contentPB.PostAnswerByQuestionIDRequest) error {
-	if req.QuestionID == "" || req.Answer == "" || req.UserID == "" {
-		return errors.New("question_id, answer, and user_id are required")
+func (l Limits) checkAnswer(answer string) error {
+	if len(answer) < l.MinAnswerLength {
+		return errs.InvalidField("answer", fmt.Sprintf("must be at least %d characters long", l.MinAnswerLength))
 	}
 
-	if len(req.Answer) < 20 {
-		return errors.New("answer must be at least 20 characters long")
+	if len(answer) > l.MaxAnswerLength {
+		return errs.InvalidField("answer", fmt.Sprintf("must be at most %d characters long", l.MaxAnswerLength))
 	}
 
 	return nil
 }
 
+type voteResult struct {
+	message   string
+	upvotes   int32
+	downvotes int32
+	userVote  string
+}
+
+// voteOnAnswer applies a vote of the given type. Repeating the user's current
+// vote retracts it, and voting the other way switches it.
+func (s *ContentService) voteOnAnswer(ctx context.Context, questionID, answerID, userID, voteType string) (*voteResult, error) {
+	ownerID, err := s.repo.GetAnswerOwnerID(ctx, questionID, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ownerID == userID {
+		return nil, errs.PermissionDeniedf("cannot vote on your own answer")
+	}
+
+	answer, err := s.repo.ToggleVote(ctx, questionID, answerID, userID, voteType)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &voteResult{
+		message:   "Vote removed successfully",
+		upvotes:   int32(answer.Upvotes),
+		downvotes: int32(answer.Downvotes),
+	}
+	for _, vote := range answer.Vote {
+		if vote.UserID == userID {
+			result.userVote = vote.VoteType
+		}
+	}
+	switch result.userVote {
+	case models.VoteTypeUpvote:
+		result.message = "Answer upvoted successfully"
+	case models.VoteTypeDownvote:
+		result.message = "Answer downvoted successfully"
+	}
+	return result, nil
+}
+
 func sanitizeTags(tags []string) []string {
 	sanitized := make([]string, 0, len(tags))
 	for _, tag := range tags {
-		tag = strings.TrimSpace(strings.ToLower(tag))
-		if tag != "" && len(tag) <= 10 {
+		tag = normalizeTag(tag)
+		if tag != "" && len(tag) <= maxTagLength {
 			sanitized = append(sanitized, tag)
 		}
 	}
 	return sanitized
 }
 
+func normalizeTag(tag string) string {
+	return strings.TrimSpace(strings.ToLower(tag))
+}
+
+func convertToProtoQuestion(q *models.Question) *contentPB.Question {
+	var acceptedAnswerID string
+	if q.AcceptedAnswerID != nil {
+		acceptedAnswerID = q.AcceptedAnswerID.Hex()
+	}
+
+	return &contentPB.Question{
+		QuestionID:       q.ID.Hex(),
+		Question:         q.Question,
+		UserID:           q.UserID,
+		CreatedAt:        q.CreatedAt.Unix(),
+		Tags:             q.Tags,
+		IsAnswered:       q.IsAnswered,
+		Details:          q.Details,
+		AnswerCount:      int32(q.AnswerCount),
+		UpdatedAt:        q.UpdatedAt.Unix(),
+		AcceptedAnswerID: acceptedAnswerID,
+		CommentCount:     int32(q.CommentCount),
+		IsHidden:         q.IsHidden,
+	}
+}
+
 func convertToProtoQuestions(questions []models.Question) []*contentPB.Question {
 	protoQuestions := make([]*contentPB.Question, len(questions))
-	for i, q := range questions {
-		protoQuestions[i] = &contentPB.Question{
-			QuestionID: q.ID.Hex(),
-			Question:   q.Question,
-			UserID:     q.UserID,
-			CreatedAt:  q.CreatedAt.Unix(),
-			Tags:       q.Tags,
-			IsAnswered: q.IsAnswered,
-			Details:    q.Details,
-		}
+	for i := range questions {
+		protoQuestions[i] = convertToProtoQuestion(&questions[i])
 	}
 	return protoQuestions
 }
+
+func convertToProtoAnswer(answer *models.Answer) *contentPB.Answer {
+	return &contentPB.Answer{
+		Id:           answer.ID.Hex(),
+		QuestionId:   answer.QuestionID.Hex(),
+		UserId:       answer.UserID,
+		AnswerText:   answer.Answer,
+		Upvotes:      int32(answer.Upvotes),
+		Downvotes:    int32(answer.Downvotes),
+		IsFlagged:    answer.IsFlagged,
+		CreatedAt:    answer.CreatedAt.Unix(),
+		UpdatedAt:    answer.UpdatedAt.Unix(),
+		CommentCount: int32(answer.CommentCount),
+		IsHidden:     answer.IsHidden,
+	}
+}
+
+func convertToProtoAnswers(answers []models.Answer) []*contentPB.Answer {
+	protoAnswers := make([]*contentPB.Answer, len(answers))
+	for i := range answers {
+		protoAnswers[i] = convertToProtoAnswer(&answers[i])
+	}
+	return protoAnswers
+}
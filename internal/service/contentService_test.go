@@ -0,0 +1,42 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	contentPB "github.com/liju-github/ContentService/proto/content"
+)
+
+// readPrefixes start the names of the RPCs that change nothing.
+var readPrefixes = []string{"Get", "List", "Search", "Count"}
+
+func TestWriteMethodsCoverEveryMutatingRPC(t *testing.T) {
+	desc := contentPB.ContentService_ServiceDesc
+	write := make(map[string]bool, len(WriteMethods))
+	for _, method := range WriteMethods {
+		write[method] = true
+	}
+
+	known := make(map[string]bool, len(desc.Methods))
+	for _, method := range desc.Methods {
+		fullName := "/" + desc.ServiceName + "/" + method.MethodName
+		known[fullName] = true
+
+		reads := false
+		for _, prefix := range readPrefixes {
+			reads = reads || strings.HasPrefix(method.MethodName, prefix)
+		}
+		if reads && write[fullName] {
+			t.Errorf("read-only RPC %s is in WriteMethods", method.MethodName)
+		}
+		if !reads && !write[fullName] {
+			t.Errorf("mutating RPC %s is missing from WriteMethods", method.MethodName)
+		}
+	}
+
+	for _, method := range WriteMethods {
+		if !known[method] {
+			t.Errorf("WriteMethods lists %s, which the service does not have", method)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/liju-github/ContentService/internal/auth"
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	contentPB "github.com/liju-github/ContentService/proto/content"
+)
+
+func (s *ContentService) GetUserFeed(ctx context.Context, req *contentPB.GetUserFeedRequest) (*contentPB.GetUserFeedResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := models.FeedQuery{
+		UserID:         userID,
+		Tags:           sanitizeTags(req.Tags),
+		UnansweredOnly: req.UnansweredOnly,
+		AsOf:           time.Now(),
+		Limit:          pageSize(req.PageSize),
+	}
+
+	if req.PageToken != "" {
+		asOf, offset, err := decodeFeedToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		query.AsOf, query.Offset = asOf, offset
+	}
+
+	result, err := s.repo.GetUserFeed(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if result.HasMore {
+		nextPageToken = encodeFeedToken(query.AsOf, query.Offset+len(result.Questions))
+	}
+
+	return &contentPB.GetUserFeedResponse{
+		Questions:     convertToProtoQuestions(result.Questions),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *ContentService) Follow(ctx context.Context, req *contentPB.FollowRequest) (*contentPB.FollowResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targetType, target, err := validateFollow(userID, req.TargetType, req.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	follow := &models.Follow{
+		UserID:     userID,
+		TargetType: targetType,
+		Target:     target,
+	}
+
+	if err := s.repo.Follow(ctx, follow); err != nil {
+		return nil, err
+	}
+
+	return &contentPB.FollowResponse{
+		Success: true,
+		Message: "Followed " + targetType + " successfully",
+		Follow:  convertToProtoFollow(follow),
+	}, nil
+}
+
+func (s *ContentService) Unfollow(ctx context.Context, req *contentPB.UnfollowRequest) (*contentPB.UnfollowResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targetType, target, err := validateFollow(userID, req.TargetType, req.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Unfollow(ctx, userID, targetType, target); err != nil {
+		return nil, err
+	}
+
+	return &contentPB.UnfollowResponse{
+		Success: true,
+		Message: "Unfollowed " + targetType + " successfully",
+	}, nil
+}
+
+// ListFollows lists what req.UserID follows, or the caller when it is empty.
+func (s *ContentService) ListFollows(ctx context.Context, req *contentPB.ListFollowsRequest) (*contentPB.ListFollowsResponse, error) {
+	userID := req.UserID
+	if userID == "" {
+		var err error
+		if userID, err = auth.UserID(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	targetType := strings.ToLower(strings.TrimSpace(req.TargetType))
+	if targetType != "" && targetType != models.FollowTag && targetType != models.FollowUser {
+		return nil, errs.InvalidField("target_type", "must be tag or user")
+	}
+
+	page, err := pageRequest(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.ListFollows(ctx, userID, targetType, page)
+	if err != nil {
+		return nil, err
+	}
+
+	follows := make([]*contentPB.Follow, len(result.Follows))
+	for i := range result.Follows {
+		follows[i] = convertToProtoFollow(&result.Follows[i])
+	}
+
+	return &contentPB.ListFollowsResponse{
+		Follows:       follows,
+		NextPageToken: encodePageToken(result.Next),
+	}, nil
+}
+
+// validateFollow checks a follow request and returns its normalized target
+// type and target.
+func validateFollow(userID, targetType, target string) (string, string, error) {
+	switch targetType = strings.ToLower(strings.TrimSpace(targetType)); targetType {
+	case models.FollowTag:
+		name, err := validateTagName(target)
+		if err != nil {
+			return "", "", err
+		}
+		return targetType, name, nil
+	case models.FollowUser:
+		target = strings.TrimSpace(target)
+		if target == "" {
+			return "", "", errs.InvalidField("target", "is required")
+		}
+		if target == userID {
+			return "", "", errs.InvalidField("target", "users cannot follow themselves")
+		}
+		return targetType, target, nil
+	default:
+		return "", "", errs.InvalidField("target_type", "must be tag or user")
+	}
+}
+
+func convertToProtoFollow(follow *models.Follow) *contentPB.Follow {
+	return &contentPB.Follow{
+		FollowID:   follow.ID.Hex(),
+		UserID:     follow.UserID,
+		TargetType: follow.TargetType,
+		Target:     follow.Target,
+		CreatedAt:  follow.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/liju-github/ContentService/internal/auth"
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	"github.com/liju-github/ContentService/internal/policy"
+	contentPB "github.com/liju-github/ContentService/proto/content"
+)
+
+// DefaultAutoHide is the AutoHide used unless WithAutoHide sets another.
+var DefaultAutoHide = models.DefaultAutoHide
+
+// moderatorFlagWeight is how much a moderator's flag counts towards the
+// AutoHide score. Other users' flags count 1.
+const moderatorFlagWeight = 3
+
+const maxFlagDetailsLength = 500
+
+// flagReasonNames maps FlagReason values to the reasons stored on flags, and
+// flagReasonValues maps them back.
+var (
+	flagReasonNames = map[contentPB.FlagReason]string{
+		contentPB.FlagReason_FLAG_REASON_SPAM:        models.FlagReasonSpam,
+		contentPB.FlagReason_FLAG_REASON_OFFENSIVE:   models.FlagReasonOffensive,
+		contentPB.FlagReason_FLAG_REASON_OFF_TOPIC:   models.FlagReasonOffTopic,
+		contentPB.FlagReason_FLAG_REASON_DUPLICATE:   models.FlagReasonDuplicate,
+		contentPB.FlagReason_FLAG_REASON_LOW_QUALITY: models.FlagReasonLowQuality,
+		contentPB.FlagReason_FLAG_REASON_OTHER:       models.FlagReasonOther,
+	}
+	flagReasonValues = make(map[string]contentPB.FlagReason, len(flagReasonNames))
+)
+
+func init() {
+	for value, name := range flagReasonNames {
+		flagReasonValues[name] = value
+	}
+}
+
+// protoFlagReason returns the FlagReason of a stored reason. Free-text
+// reasons left by older versions count as other.
+func protoFlagReason(reason string) contentPB.FlagReason {
+	if value, ok := flagReasonValues[reason]; ok {
+		return value
+	}
+	return contentPB.FlagReason_FLAG_REASON_OTHER
+}
+
+// flagActions maps the actions of ResolveFlag to the resolution recorded on
+// the flags.
+var flagActions = map[string]string{
+	"dismiss": models.FlagDismissed,
+	"hide":    models.FlagHidden,
+	"delete":  models.FlagDeleted,
+	"warn":    models.FlagWarned,
+}
+
+func (s *ContentService) ListModerationQueue(ctx context.Context, req *contentPB.ListModerationQueueRequest) (*contentPB.ListModerationQueueResponse, error) {
+	targetType := strings.ToLower(strings.TrimSpace(req.TargetType))
+	if targetType != "" {
+		if _, err := validateModerationTarget(targetType); err != nil {
+			return nil, err
+		}
+	}
+
+	var reason string
+	if req.Category != contentPB.FlagReason_FLAG_REASON_UNSPECIFIED {
+		var ok bool
+		if reason, ok = flagReasonNames[req.Category]; !ok {
+			return nil, errs.InvalidField("category", "unknown flag reason")
+		}
+	}
+
+	page, err := pageRequest(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.ListModerationQueue(ctx, targetType, reason, page)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*contentPB.ModerationItem, len(result.Items))
+	for i := range result.Items {
+		items[i] = convertToProtoModerationItem(&result.Items[i])
+	}
+
+	return &contentPB.ListModerationQueueResponse{
+		Items:         items,
+		NextPageToken: encodePageToken(result.Next),
+	}, nil
+}
+
+// CountFlagsByReason counts flags per reason for the moderation dashboards.
+// Every reason is listed, including those without flags.
+func (s *ContentService) CountFlagsByReason(ctx context.Context, req *contentPB.CountFlagsByReasonRequest) (*contentPB.CountFlagsByReasonResponse, error) {
+	query := models.FlagCountQuery{IncludeResolved: req.IncludeResolved}
+	if req.TargetType != "" {
+		targetType, err := validateModerationTarget(req.TargetType)
+		if err != nil {
+			return nil, err
+		}
+		query.TargetType = targetType
+	}
+	if req.Since > 0 {
+		query.Since = time.Unix(req.Since, 0)
+	}
+
+	counts, err := s.repo.CountFlagsByReason(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	byReason := make(map[contentPB.FlagReason]int64, len(flagReasonNames))
+	var total int64
+	for reason, count := range counts {
+		byReason[protoFlagReason(reason)] += count
+		total += count
+	}
+
+	resp := &contentPB.CountFlagsByReasonResponse{Total: total}
+	for _, reason := range models.FlagReasons {
+		value := flagReasonValues[reason]
+		resp.Counts = append(resp.Counts, &contentPB.FlagReasonCount{
+			Category: value,
+			Count:    byReason[value],
+		})
+	}
+	return resp, nil
+}
+
+// ResolveFlag resolves every open flag of a post. The flags are resolved
+// before the action is applied, so that of two moderators acting on the same
+// flags only one goes on to delete the post or warn its author. If the action
+// then fails, the flags are reopened and the failure is audited.
+func (s *ContentService) ResolveFlag(ctx context.Context, req *contentPB.ResolveFlagRequest) (*contentPB.ResolveFlagResponse, error) {
+	moderatorID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := required(field{"target_type", req.TargetType}, field{"target_id", req.TargetID}, field{"action", req.Action}); err != nil {
+		return nil, err
+	}
+
+	targetType, err := validateModerationTarget(req.TargetType)
+	if err != nil {
+		return nil, err
+	}
+
+	resolution, ok := flagActions[strings.ToLower(strings.TrimSpace(req.Action))]
+	if !ok {
+		return nil, errs.InvalidField("action", "must be dismiss, hide, delete or warn")
+	}
+
+	note := strings.TrimSpace(req.Note)
+	if note == "" && (resolution == models.FlagDeleted || resolution == models.FlagWarned) {
+		return nil, errs.InvalidField("note", "is required to delete a post or warn its author")
+	}
+
+	item, err := s.repo.GetModerationItem(ctx, targetType, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	flagResolution := models.FlagResolution{
+		Resolution:  resolution,
+		ModeratorID: moderatorID,
+		Note:        note,
+	}
+	if err := s.repo.ResolveFlags(ctx, targetType, req.TargetID, flagResolution); err != nil {
+		return nil, err
+	}
+
+	switch resolution {
+	case models.FlagDeleted:
+		err = s.deleteFlagged(ctx, item, moderatorID, note)
+	case models.FlagWarned:
+		err = s.repo.AddWarning(ctx, &models.Warning{
+			UserID:      item.UserID,
+			ModeratorID: moderatorID,
+			TargetType:  item.TargetType,
+			TargetID:    item.TargetID,
+			Reason:      note,
+		})
+	}
+	if err != nil {
+		s.reopenFlags(ctx, item, flagResolution, err)
+		return nil, err
+	}
+
+	s.audit(ctx, models.AuditEvent{
+		Action:     models.AuditFlagResolve,
+		TargetType: targetType,
+		TargetID:   item.TargetID.Hex(),
+		Reason:     note,
+		Before:     snapshot(item),
+		After:      snapshot(flagResolution),
+	})
+
+	return &contentPB.ResolveFlagResponse{
+		Success: true,
+		Message: "Flags resolved: " + resolution,
+	}, nil
+}
+
+// failedResolution is the After snapshot of a resolution whose action failed.
+type failedResolution struct {
+	models.FlagResolution `bson:",inline"`
+	Error                 string `bson:"error"`
+	Reopened              bool   `bson:"reopened"`
+}
+
+// reopenFlags undoes the resolution of item's flags after its action failed
+// with actionErr, and audits the outcome. If the flags cannot be reopened
+// they stay resolved without the action, which the audit event records.
+func (s *ContentService) reopenFlags(ctx context.Context, item *models.ModerationItem, resolution models.FlagResolution, actionErr error) {
+	err := s.repo.ReopenFlags(ctx, item.TargetType, item.TargetID.Hex(), resolution)
+	if err != nil {
+		log.Printf("moderation: failed to reopen flags on %s %s after %s failed: %v",
+			item.TargetType, item.TargetID.Hex(), resolution.Resolution, err)
+	}
+
+	s.audit(ctx, models.AuditEvent{
+		Action:     models.AuditFlagReopen,
+		TargetType: item.TargetType,
+		TargetID:   item.TargetID.Hex(),
+		Reason:     resolution.Note,
+		Before:     snapshot(item),
+		After: snapshot(failedResolution{
+			FlagResolution: resolution,
+			Error:          actionErr.Error(),
+			Reopened:       err == nil,
+		}),
+	})
+}
+
+// deleteFlagged deletes a post on behalf of a moderator.
+func (s *ContentService) deleteFlagged(ctx context.Context, item *models.ModerationItem, moderatorID, reason string) error {
+	switch item.TargetType {
+	case models.TargetQuestion:
+		return s.repo.DeleteQuestion(ctx, item.TargetID.Hex(), moderatorID, reason)
+	case models.TargetAnswer:
+		return s.repo.DeleteAnswer(ctx, item.QuestionID.Hex(), item.TargetID.Hex(), moderatorID, reason)
+	default:
+		return s.repo.DeleteComment(ctx, item.TargetID.Hex())
+	}
+}
+
+// newFlag returns a flag from userID, weighted by the caller's role. Old
+// clients send only a free-text legacy reason, which is used as the category
+// if it names one and otherwise kept as the details of an "other" flag.
+func (s *ContentService) newFlag(ctx context.Context, userID string, category contentPB.FlagReason, legacy, details string) (models.Flag, error) {
+	details = strings.TrimSpace(details)
+	if len(details) > maxFlagDetailsLength {
+		return models.Flag{}, errs.InvalidField("details", fmt.Sprintf("must be at most %d characters long", maxFlagDetailsLength))
+	}
+
+	var reason string
+	switch legacy = strings.TrimSpace(legacy); {
+	case category != contentPB.FlagReason_FLAG_REASON_UNSPECIFIED:
+		var ok bool
+		if reason, ok = flagReasonNames[category]; !ok {
+			return models.Flag{}, errs.InvalidField("category", "unknown flag reason")
+		}
+	case legacy == "":
+		return models.Flag{}, errs.InvalidField("category", "is required")
+	case flagReasonValues[strings.ToLower(legacy)] != contentPB.FlagReason_FLAG_REASON_UNSPECIFIED:
+		reason = strings.ToLower(legacy)
+	default:
+		reason = models.FlagReasonOther
+		if details == "" {
+			details = legacy
+		}
+	}
+
+	weight := 1.0
+	if policy.HasRole(ctx, policy.RoleModerator) {
+		weight = moderatorFlagWeight
+	}
+
+	return models.Flag{
+		UserID:  userID,
+		Reason:  reason,
+		Details: details,
+		Weight:  weight,
+	}, nil
+}
+
+func validateModerationTarget(targetType string) (string, error) {
+	switch targetType = strings.ToLower(strings.TrimSpace(targetType)); targetType {
+	case models.TargetQuestion, models.TargetAnswer, models.TargetComment:
+		return targetType, nil
+	default:
+		return "", errs.InvalidField("target_type", "must be question, answer or comment")
+	}
+}
+
+func convertToProtoModerationItem(item *models.ModerationItem) *contentPB.ModerationItem {
+	flags := make([]*contentPB.Flag, len(item.Flags))
+	for i, flag := range item.Flags {
+		flags[i] = &contentPB.Flag{
+			UserID:    flag.UserID,
+			Reason:    flag.Reason,
+			CreatedAt: flag.CreatedAt.Unix(),
+			Category:  protoFlagReason(flag.Reason),
+			Details:   flag.Details,
+		}
+	}
+
+	return &contentPB.ModerationItem{
+		TargetType: item.TargetType,
+		TargetID:   item.TargetID.Hex(),
+		QuestionID: item.QuestionID.Hex(),
+		UserID:     item.UserID,
+		Body:       item.Body,
+		IsHidden:   item.IsHidden,
+		AutoHidden: item.AutoHidden,
+		Flags:      flags,
+		FlaggedAt:  item.FlaggedAt.Unix(),
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// pageSize clamps a requested page size to the server limits.
+func pageSize(requested int32) int {
+	switch {
+	case requested <= 0:
+		return defaultPageSize
+	case requested > maxPageSize:
+		return maxPageSize
+	default:
+		return int(requested)
+	}
+}
+
+// pageRequest turns the page_size and page_token of a list request into a
+// repository page.
+func pageRequest(size int32, token string) (models.Page, error) {
+	after, err := decodePageToken(token)
+	if err != nil {
+		return models.Page{}, err
+	}
+
+	return models.Page{Limit: pageSize(size), After: after}, nil
+}
+
+// Page tokens are opaque to clients. They encode the (created_at, _id) of the
+// last item returned, which listings resume after.
+const cursorTokenPrefix = "cursor:"
+
+func encodePageToken(cursor *models.Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	raw := cursorTokenPrefix + strconv.FormatInt(cursor.CreatedAt.UnixMilli(), 10) + ":" + cursor.ID.Hex()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (*models.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !strings.HasPrefix(string(raw), cursorTokenPrefix) {
+		return nil, errs.InvalidField("page_token", "is not a valid page token")
+	}
+
+	millis, hex, ok := strings.Cut(strings.TrimPrefix(string(raw), cursorTokenPrefix), ":")
+	if !ok {
+		return nil, errs.InvalidField("page_token", "is not a valid page token")
+	}
+
+	createdAt, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil {
+		return nil, errs.InvalidField("page_token", "is not a valid page token")
+	}
+
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return nil, errs.InvalidField("page_token", "is not a valid page token")
+	}
+
+	return &models.Cursor{CreatedAt: time.UnixMilli(createdAt), ID: id}, nil
+}
+
+// Offset tokens are used by ranked listings, where results have no stable
+// (created_at, _id) order to resume from.
+const offsetTokenPrefix = "offset:"
+
+func encodeOffsetToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(offsetTokenPrefix + strconv.Itoa(offset)))
+}
+
+func decodeOffsetToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !strings.HasPrefix(string(raw), offsetTokenPrefix) {
+		return 0, errs.InvalidField("page_token", "is not a valid page token")
+	}
+
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(raw), offsetTokenPrefix))
+	if err != nil || offset < 0 {
+		return 0, errs.InvalidField("page_token", "is not a valid page token")
+	}
+
+	return offset, nil
+}
+
+// Feed tokens carry the time the first page was ranked at along with the
+// offset, so that later pages are ranked the same way.
+const feedTokenPrefix = "feed:"
+
+func encodeFeedToken(asOf time.Time, offset int) string {
+	raw := feedTokenPrefix + strconv.FormatInt(asOf.UnixMilli(), 10) + ":" + strconv.Itoa(offset)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeFeedToken(token string) (time.Time, int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !strings.HasPrefix(string(raw), feedTokenPrefix) {
+		return time.Time{}, 0, errs.InvalidField("page_token", "is not a valid page token")
+	}
+
+	millis, offsetText, ok := strings.Cut(strings.TrimPrefix(string(raw), feedTokenPrefix), ":")
+	if !ok {
+		return time.Time{}, 0, errs.InvalidField("page_token", "is not a valid page token")
+	}
+
+	asOf, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, errs.InvalidField("page_token", "is not a valid page token")
+	}
+
+	offset, err := strconv.Atoi(offsetText)
+	if err != nil || offset < 0 {
+		return time.Time{}, 0, errs.InvalidField("page_token", "is not a valid page token")
+	}
+
+	return time.UnixMilli(asOf), offset, nil
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/liju-github/ContentService/internal/auth"
+	"github.com/liju-github/ContentService/internal/diff"
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	contentPB "github.com/liju-github/ContentService/proto/content"
+)
+
+const maxEditSummaryLength = 300
+
+func (s *ContentService) EditQuestion(ctx context.Context, req *contentPB.EditQuestionRequest) (*contentPB.EditQuestionResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateEditQuestion(req, s.limits); err != nil {
+		return nil, err
+	}
+
+	// Verify user owns the question
+	questionOwnerID, err := s.repo.GetUserIDFromQuestionID(ctx, req.QuestionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if questionOwnerID != userID {
+		return nil, errs.PermissionDeniedf("only the owner can edit this post")
+	}
+
+	tags, err := s.resolveTags(ctx, sanitizeTags(req.Tags))
+	if err != nil {
+		return nil, err
+	}
+
+	question, err := s.repo.EditQuestion(ctx, req.QuestionID, &models.Revision{
+		EditorID:    userID,
+		EditSummary: strings.TrimSpace(req.EditSummary),
+		Question:    strings.TrimSpace(req.Question),
+		Details:     strings.TrimSpace(req.Details),
+		Tags:        tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &contentPB.EditQuestionResponse{
+		Success:  true,
+		Message:  "Question edited successfully",
+		Question: convertToProtoQuestion(question),
+	}, nil
+}
+
+func (s *ContentService) EditAnswer(ctx context.Context, req *contentPB.EditAnswerRequest) (*contentPB.EditAnswerResponse, error) {
+	userID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateEditAnswer(req, s.limits); err != nil {
+		return nil, err
+	}
+
+	// Verify user owns the answer
+	answerOwnerID, err := s.repo.GetAnswerOwnerID(ctx, req.QuestionID, req.AnswerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if answerOwnerID != userID {
+		return nil, errs.PermissionDeniedf("only the owner can edit this post")
+	}
+
+	answer, err := s.repo.EditAnswer(ctx, req.QuestionID, req.AnswerID, &models.Revision{
+		EditorID:    userID,
+		EditSummary: strings.TrimSpace(req.EditSummary),
+		Answer:      strings.TrimSpace(req.Answer),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &contentPB.EditAnswerResponse{
+		Success: true,
+		Message: "Answer edited successfully",
+		Answer:  convertToProtoAnswer(answer),
+	}, nil
+}
+
+func (s *ContentService) GetRevisions(ctx context.Context, req *contentPB.GetRevisionsRequest) (*contentPB.GetRevisionsResponse, error) {
+	if err := required(field{"question_id", req.QuestionID}); err != nil {
+		return nil, err
+	}
+
+	var (
+		revisions []models.Revision
+		original  models.Revision
+	)
+
+	if req.AnswerID == "" {
+		question, err := s.repo.GetQuestionByID(ctx, req.QuestionID)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions, err = s.repo.GetRevisions(ctx, models.TargetQuestion, req.QuestionID)
+		if err != nil {
+			return nil, err
+		}
+
+		original = models.Revision{
+			TargetType: models.TargetQuestion,
+			EditorID:   question.UserID,
+			Question:   question.Question,
+			Details:    question.Details,
+			Tags:       question.Tags,
+			CreatedAt:  question.CreatedAt,
+		}
+	} else {
+		answer, err := s.repo.GetAnswerByID(ctx, req.QuestionID, req.AnswerID)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions, err = s.repo.GetRevisions(ctx, models.TargetAnswer, req.AnswerID)
+		if err != nil {
+			return nil, err
+		}
+
+		original = models.Revision{
+			TargetType: models.TargetAnswer,
+			EditorID:   answer.UserID,
+			Answer:     answer.Answer,
+			CreatedAt:  answer.CreatedAt,
+		}
+	}
+
+	// A post that was never edited only has its original version
+	if len(revisions) == 0 {
+		original.Revision = 1
+		revisions = []models.Revision{original}
+	}
+
+	protoRevisions := make([]*contentPB.Revision, len(revisions))
+	previous := ""
+	for i, revision := range revisions {
+		text := revisionText(&revision)
+		protoRevisions[i] = &contentPB.Revision{
+			Revision:    int32(revision.Revision),
+			EditorID:    revision.EditorID,
+			EditSummary: revision.EditSummary,
+			CreatedAt:   revision.CreatedAt.Unix(),
+			Question:    revision.Question,
+			Details:     revision.Details,
+			Tags:        revision.Tags,
+			Answer:      revision.Answer,
+			Diff:        diff.Lines(previous, text),
+		}
+		previous = text
+	}
+
+	return &contentPB.GetRevisionsResponse{
+		Revisions: protoRevisions,
+	}, nil
+}
+
+// revisionText renders a revision as the text that revisions are diffed on.
+func revisionText(revision *models.Revision) string {
+	if revision.TargetType == models.TargetAnswer {
+		return revision.Answer
+	}
+
+	return "Title: " + revision.Question + "\n" +
+		"Tags: " + strings.Join(revision.Tags, ", ") + "\n\n" +
+		revision.Details
+}
+
+func validateEditQuestion(req *contentPB.EditQuestionRequest, limits Limits) error {
+	if err := required(field{"question_id", req.QuestionID}, field{"question", req.Question}); err != nil {
+		return err
+	}
+
+	if err := limits.checkQuestion(req.Question, req.Details, req.Tags); err != nil {
+		return err
+	}
+
+	if len(req.EditSummary) > maxEditSummaryLength {
+		return errs.InvalidField("edit_summary", fmt.Sprintf("must be at most %d characters long", maxEditSummaryLength))
+	}
+
+	return nil
+}
+
+func validateEditAnswer(req *contentPB.EditAnswerRequest, limits Limits) error {
+	if err := required(field{"question_id", req.QuestionID}, field{"answer_id", req.AnswerID}, field{"answer", req.Answer}); err != nil {
+		return err
+	}
+
+	if err := limits.checkAnswer(req.Answer); err != nil {
+		return err
+	}
+
+	if len(req.EditSummary) > maxEditSummaryLength {
+		return errs.InvalidField("edit_summary", fmt.Sprintf("must be at most %d characters long", maxEditSummaryLength))
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	mongodb "github.com/liju-github/ContentService/internal/repository"
+	contentPB "github.com/liju-github/ContentService/proto/content"
+)
+
+const (
+	snippetRadius  = 80
+	highlightOpen  = "<em>"
+	highlightClose = "</em>"
+)
+
+func (s *ContentService) SearchQuestionsAnswersUsers(ctx context.Context, req *contentPB.SearchRequest) (*contentPB.SearchResponse, error) {
+	keyword := strings.TrimSpace(req.Keyword)
+	if keyword == "" {
+		return nil, errs.InvalidField("keyword", "is required")
+	}
+
+	offset, err := decodeOffsetToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	limit := pageSize(req.PageSize)
+
+	result, err := s.repo.SearchQuestionsAnswersUsers(ctx, keyword, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher := keywordMatcher(keyword)
+	questions := make([]models.Question, len(result.Hits))
+	hits := make([]*contentPB.SearchHit, len(result.Hits))
+	for i, hit := range result.Hits {
+		questions[i] = hit.Question
+		hits[i] = buildSearchHit(hit, matcher)
+	}
+
+	protoQuestions := convertToProtoQuestions(questions)
+	for i := range hits {
+		hits[i].Question = protoQuestions[i]
+	}
+
+	var nextPageToken string
+	if result.Next > 0 {
+		nextPageToken = encodeOffsetToken(result.Next)
+	}
+
+	return &contentPB.SearchResponse{
+		Questions:     protoQuestions,
+		Hits:          hits,
+		NextPageToken: nextPageToken,
+		TotalResults:  result.Total,
+	}, nil
+}
+
+// keywordMatcher builds a case-insensitive pattern matching any of the
+// keyword's terms. Terms are quoted, so user input is never run as a regex.
+func keywordMatcher(keyword string) *regexp.Regexp {
+	terms := mongodb.SearchTerms(keyword)
+	if len(terms) == 0 {
+		return nil
+	}
+	for i, term := range terms {
+		terms[i] = regexp.QuoteMeta(term)
+	}
+	return regexp.MustCompile(`(?i)(` + strings.Join(terms, "|") + `)`)
+}
+
+// buildSearchHit works out which fields matched and builds a highlighted
+// snippet from the first of them, preferring the title over details and
+// details over answers. The search itself is stemmed, so a hit may match
+// none of the literal terms; in that case the title is used as the snippet.
+func buildSearchHit(hit models.SearchHit, matcher *regexp.Regexp) *contentPB.SearchHit {
+	pbHit := &contentPB.SearchHit{Score: hit.Score}
+
+	var snippetSource string
+	match := func(field, text string) bool {
+		if matcher == nil || !matcher.MatchString(text) {
+			return false
+		}
+		pbHit.MatchedFields = append(pbHit.MatchedFields, field)
+		if snippetSource == "" {
+			snippetSource = text
+		}
+		return true
+	}
+
+	match("question", hit.Question.Question)
+	match("details", hit.Question.Details)
+	if hit.Answer != nil {
+		pbHit.AnswerID = hit.Answer.ID.Hex()
+		if !match("answer", hit.Answer.Answer) {
+			// The answer matched the search on a stemmed form only
+			pbHit.MatchedFields = append(pbHit.MatchedFields, "answer")
+		}
+	}
+
+	if snippetSource == "" {
+		pbHit.Snippet = html.EscapeString(truncate(hit.Question.Question, 2*snippetRadius))
+		return pbHit
+	}
+
+	pbHit.Snippet = highlight(snippetAround(snippetSource, matcher), matcher)
+	return pbHit
+}
+
+// snippetAround cuts a window of text around the first match.
+func snippetAround(text string, matcher *regexp.Regexp) string {
+	loc := matcher.FindStringIndex(text)
+	start, end := loc[0]-snippetRadius, loc[1]+snippetRadius
+	prefix, suffix := "...", "..."
+	if start <= 0 {
+		start, prefix = 0, ""
+	}
+	if end >= len(text) {
+		end, suffix = len(text), ""
+	}
+
+	// Do not split multi-byte characters at the window edges
+	for start > 0 && !utf8.RuneStart(text[start]) {
+		start--
+	}
+	for end < len(text) && !utf8.RuneStart(text[end]) {
+		end++
+	}
+
+	return prefix + text[start:end] + suffix
+}
+
+// highlight escapes text as HTML and wraps each match in highlightOpen and
+// highlightClose. Matches are found before escaping so that terms containing
+// characters such as & still match.
+func highlight(text string, matcher *regexp.Regexp) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range matcher.FindAllStringIndex(text, -1) {
+		b.WriteString(html.EscapeString(text[last:loc[0]]))
+		b.WriteString(highlightOpen)
+		b.WriteString(html.EscapeString(text[loc[0]:loc[1]]))
+		b.WriteString(highlightClose)
+		last = loc[1]
+	}
+	b.WriteString(html.EscapeString(text[last:]))
+	return b.String()
+}
+
+func truncate(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:max]) + "..."
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/liju-github/ContentService/internal/models"
+)
+
+func TestBuildSearchHitEscapesSnippet(t *testing.T) {
+	tests := []struct {
+		name     string
+		keyword  string
+		question string
+		want     string
+	}{
+		{
+			name:     "markup around a match",
+			keyword:  "zebra",
+			question: "<script>alert(1)</script> zebra",
+			want:     "&lt;script&gt;alert(1)&lt;/script&gt; <em>zebra</em>",
+		},
+		{
+			name:     "term with a special character",
+			keyword:  "a&b",
+			question: "What is a&b?",
+			want:     "What is <em>a&amp;b</em>?",
+		},
+		{
+			name:     "no literal match",
+			keyword:  "zebras",
+			question: "<b>zebra</b>",
+			want:     "&lt;b&gt;zebra&lt;/b&gt;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hit := models.SearchHit{Question: models.Question{Question: tt.question}}
+			if got := buildSearchHit(hit, keywordMatcher(tt.keyword)).Snippet; got != tt.want {
+				t.Errorf("snippet = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/liju-github/ContentService/internal/errs"
+	"github.com/liju-github/ContentService/internal/models"
+	contentPB "github.com/liju-github/ContentService/proto/content"
+)
+
+// TagPolicy controls how PostQuestion treats tags that are not in the catalog.
+type TagPolicy string
+
+const (
+	// TagPolicyAllow accepts any tag that passes sanitizeTags.
+	TagPolicyAllow TagPolicy = models.TagPolicyAllow
+	// TagPolicyReject rejects questions that use tags missing from the catalog.
+	TagPolicyReject TagPolicy = models.TagPolicyReject
+	// TagPolicyCreate adds missing tags to the catalog.
+	TagPolicyCreate TagPolicy = models.TagPolicyCreate
+)
+
+func ParseTagPolicy(value string) (TagPolicy, error) {
+	switch policy := TagPolicy(strings.ToLower(strings.TrimSpace(value))); policy {
+	case "":
+		return TagPolicyAllow, nil
+	case TagPolicyAllow, TagPolicyReject, TagPolicyCreate:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown tag policy %q", value)
+	}
+}
+
+func (s *ContentService) AddTag(ctx context.Context, req *contentPB.AddTagRequest) (*contentPB.AddTagResponse, error) {
+	name, err := validateTagName(req.TagName)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := &models.Tag{
+		Name:        name,
+		Description: strings.TrimSpace(req.Description),
+	}
+
+	if err := s.repo.AddTag(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, models.AuditEvent{
+		Action:     models.AuditTagAdd,
+		TargetType: models.TargetTag,
+		TargetID:   tag.Name,
+		After:      snapshot(tag),
+	})
+
+	return &contentPB.AddTagResponse{
+		Success: true,
+		Message: "Tag added successfully",
+		Tag:     convertToProtoTag(tag),
+	}, nil
+}
+
+func (s *ContentService) RemoveTag(ctx context.Context, req *contentPB.RemoveTagRequest) (*contentPB.RemoveTagResponse, error) {
+	name, err := validateTagName(req.TagName)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := s.repo.GetTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RemoveTag(ctx, name); err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, models.AuditEvent{
+		Action:     models.AuditTagRemove,
+		TargetType: models.TargetTag,
+		TargetID:   name,
+		Before:     snapshot(before),
+	})
+
+	return &contentPB.RemoveTagResponse{
+		Success: true,
+		Message: "Tag removed successfully",
+	}, nil
+}
+
+func (s *ContentService) UpdateTag(ctx context.Context, req *contentPB.UpdateTagRequest) (*contentPB.UpdateTagResponse, error) {
+	name, err := validateTagName(req.TagName)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := s.repo.GetTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.repo.UpdateTag(ctx, name, strings.TrimSpace(req.Description))
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, models.AuditEvent{
+		Action:     models.AuditTagUpdate,
+		TargetType: models.TargetTag,
+		TargetID:   name,
+		Before:     snapshot(before),
+		After:      snapshot(tag),
+	})
+
+	return &contentPB.UpdateTagResponse{
+		Success: true,
+		Message: "Tag updated successfully",
+		Tag:     convertToProtoTag(tag),
+	}, nil
+}
+
+func (s *ContentService) GetTag(ctx context.Context, req *contentPB.GetTagRequest) (*contentPB.GetTagResponse, error) {
+	name, err := validateTagName(req.TagName)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.repo.GetTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contentPB.GetTagResponse{
+		Tag: convertToProtoTag(tag),
+	}, nil
+}
+
+func (s *ContentService) ListTags(ctx context.Context, req *contentPB.ListTagsRequest) (*contentPB.ListTagsResponse, error) {
+	page, err := pageRequest(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.ListTags(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+
+	protoTags := make([]*contentPB.Tag, len(result.Tags))
+	for i := range result.Tags {
+		protoTags[i] = convertToProtoTag(&result.Tags[i])
+	}
+
+	return &contentPB.ListTagsResponse{
+		Tags:          protoTags,
+		NextPageToken: encodePageToken(result.Next),
+	}, nil
+}
+
+// resolveTags applies the tag policy to the sanitized tags of a new question.
+func (s *ContentService) resolveTags(ctx context.Context, tags []string) ([]string, error) {
+	if s.tagPolicy == TagPolicyAllow || len(tags) == 0 {
+		return tags, nil
+	}
+
+	if s.tagPolicy == TagPolicyCreate {
+		if err := s.repo.EnsureTags(ctx, tags); err != nil {
+			return nil, err
+		}
+		return tags, nil
+	}
+
+	known, err := s.repo.GetTagsByNames(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]bool, len(known))
+	for _, tag := range known {
+		found[tag.Name] = true
+	}
+
+	var missing []string
+	for _, tag := range tags {
+		if !found[tag] {
+			missing = append(missing, tag)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, errs.InvalidField("tags", "unknown tags: "+strings.Join(missing, ", "))
+	}
+
+	return tags, nil
+}
+
+func validateTagName(name string) (string, error) {
+	name = normalizeTag(name)
+	if name == "" {
+		return "", errs.InvalidField("tag_name", "is required")
+	}
+
+	if len(name) > maxTagLength {
+		return "", errs.InvalidField("tag_name", fmt.Sprintf("must be at most %d characters long", maxTagLength))
+	}
+
+	return name, nil
+}
+
+func convertToProtoTag(tag *models.Tag) *contentPB.Tag {
+	return &contentPB.Tag{
+		TagID:       tag.ID.Hex(),
+		Name:        tag.Name,
+		Description: tag.Description,
+		CreatedAt:   tag.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/liju-github/ContentService/internal/errs"
+)
+
+// field is a request field checked by required.
+type field struct {
+	name  string
+	value string
+}
+
+// required returns an InvalidArgument error naming every field that is empty.
+func required(fields ...field) error {
+	var violations []errs.FieldViolation
+	for _, f := range fields {
+		if strings.TrimSpace(f.value) == "" {
+			violations = append(violations, errs.FieldViolation{Field: f.name, Description: "is required"})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return errs.Validation(violations...)
+}
@@ -37,7 +37,29 @@ const (
 	ContentService_GetUserFeed_FullMethodName                 = "/content.ContentService/GetUserFeed"
 	ContentService_AddTag_FullMethodName                      = "/content.ContentService/AddTag"
 	ContentService_RemoveTag_FullMethodName                   = "/content.ContentService/RemoveTag"
+	ContentService_UpdateTag_FullMethodName                   = "/content.ContentService/UpdateTag"
+	ContentService_GetTag_FullMethodName                      = "/content.ContentService/GetTag"
+	ContentService_ListTags_FullMethodName                    = "/content.ContentService/ListTags"
 	ContentService_SearchQuestionsAnswersUsers_FullMethodName = "/content.ContentService/SearchQuestionsAnswersUsers"
+	ContentService_EditQuestion_FullMethodName                = "/content.ContentService/EditQuestion"
+	ContentService_EditAnswer_FullMethodName                  = "/content.ContentService/EditAnswer"
+	ContentService_GetRevisions_FullMethodName                = "/content.ContentService/GetRevisions"
+	ContentService_AcceptAnswer_FullMethodName                = "/content.ContentService/AcceptAnswer"
+	ContentService_UnacceptAnswer_FullMethodName              = "/content.ContentService/UnacceptAnswer"
+	ContentService_PostComment_FullMethodName                 = "/content.ContentService/PostComment"
+	ContentService_EditComment_FullMethodName                 = "/content.ContentService/EditComment"
+	ContentService_DeleteComment_FullMethodName               = "/content.ContentService/DeleteComment"
+	ContentService_ListComments_FullMethodName                = "/content.ContentService/ListComments"
+	ContentService_FlagComment_FullMethodName                 = "/content.ContentService/FlagComment"
+	ContentService_Follow_FullMethodName                      = "/content.ContentService/Follow"
+	ContentService_Unfollow_FullMethodName                    = "/content.ContentService/Unfollow"
+	ContentService_ListFollows_FullMethodName                 = "/content.ContentService/ListFollows"
+	ContentService_RestoreAnswer_FullMethodName               = "/content.ContentService/RestoreAnswer"
+	ContentService_RestoreQuestion_FullMethodName             = "/content.ContentService/RestoreQuestion"
+	ContentService_ListModerationQueue_FullMethodName         = "/content.ContentService/ListModerationQueue"
+	ContentService_ResolveFlag_FullMethodName                 = "/content.ContentService/ResolveFlag"
+	ContentService_CountFlagsByReason_FullMethodName          = "/content.ContentService/CountFlagsByReason"
+	ContentService_ListAuditEvents_FullMethodName             = "/content.ContentService/ListAuditEvents"
 )
 
 // ContentServiceClient is the client API for ContentService service.
@@ -56,13 +78,38 @@ type ContentServiceClient interface {
 	DownvoteAnswerByAnswerID(ctx context.Context, in *DownvoteAnswerByAnswerIDRequest, opts ...grpc.CallOption) (*DownvoteAnswerByAnswerIDResponse, error)
 	FlagQuestion(ctx context.Context, in *FlagQuestionRequest, opts ...grpc.CallOption) (*FlagQuestionResponse, error)
 	FlagAnswer(ctx context.Context, in *FlagAnswerRequest, opts ...grpc.CallOption) (*FlagAnswerResponse, error)
+	// Deprecated: Do not use.
+	// Deprecated: use AcceptAnswer. This only confirms that the question has
+	// an accepted answer and fails with FAILED_PRECONDITION when it has none.
 	MarkQuestionAsAnswered(ctx context.Context, in *MarkQuestionAsAnsweredRequest, opts ...grpc.CallOption) (*MarkQuestionAsAnsweredResponse, error)
 	GetFlaggedQuestions(ctx context.Context, in *GetFlaggedQuestionsRequest, opts ...grpc.CallOption) (*GetFlaggedQuestionsResponse, error)
 	GetFlaggedAnswers(ctx context.Context, in *GetFlaggedAnswersRequest, opts ...grpc.CallOption) (*GetFlaggedAnswersResponse, error)
 	GetUserFeed(ctx context.Context, in *GetUserFeedRequest, opts ...grpc.CallOption) (*GetUserFeedResponse, error)
 	AddTag(ctx context.Context, in *AddTagRequest, opts ...grpc.CallOption) (*AddTagResponse, error)
 	RemoveTag(ctx context.Context, in *RemoveTagRequest, opts ...grpc.CallOption) (*RemoveTagResponse, error)
+	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error)
+	GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
 	SearchQuestionsAnswersUsers(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	EditQuestion(ctx context.Context, in *EditQuestionRequest, opts ...grpc.CallOption) (*EditQuestionResponse, error)
+	EditAnswer(ctx context.Context, in *EditAnswerRequest, opts ...grpc.CallOption) (*EditAnswerResponse, error)
+	GetRevisions(ctx context.Context, in *GetRevisionsRequest, opts ...grpc.CallOption) (*GetRevisionsResponse, error)
+	AcceptAnswer(ctx context.Context, in *AcceptAnswerRequest, opts ...grpc.CallOption) (*AcceptAnswerResponse, error)
+	UnacceptAnswer(ctx context.Context, in *UnacceptAnswerRequest, opts ...grpc.CallOption) (*UnacceptAnswerResponse, error)
+	PostComment(ctx context.Context, in *PostCommentRequest, opts ...grpc.CallOption) (*PostCommentResponse, error)
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	FlagComment(ctx context.Context, in *FlagCommentRequest, opts ...grpc.CallOption) (*FlagCommentResponse, error)
+	Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
+	Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error)
+	ListFollows(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error)
+	RestoreAnswer(ctx context.Context, in *RestoreAnswerRequest, opts ...grpc.CallOption) (*RestoreAnswerResponse, error)
+	RestoreQuestion(ctx context.Context, in *RestoreQuestionRequest, opts ...grpc.CallOption) (*RestoreQuestionResponse, error)
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error)
+	ResolveFlag(ctx context.Context, in *ResolveFlagRequest, opts ...grpc.CallOption) (*ResolveFlagResponse, error)
+	CountFlagsByReason(ctx context.Context, in *CountFlagsByReasonRequest, opts ...grpc.CallOption) (*CountFlagsByReasonResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type contentServiceClient struct {
@@ -193,6 +240,7 @@ func (c *contentServiceClient) FlagAnswer(ctx context.Context, in *FlagAnswerReq
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *contentServiceClient) MarkQuestionAsAnswered(ctx context.Context, in *MarkQuestionAsAnsweredRequest, opts ...grpc.CallOption) (*MarkQuestionAsAnsweredResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MarkQuestionAsAnsweredResponse)
@@ -253,6 +301,36 @@ func (c *contentServiceClient) RemoveTag(ctx context.Context, in *RemoveTagReque
 	return out, nil
 }
 
+func (c *contentServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTagResponse)
+	err := c.cc.Invoke(ctx, ContentService_UpdateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagResponse)
+	err := c.cc.Invoke(ctx, ContentService_GetTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *contentServiceClient) SearchQuestionsAnswersUsers(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchResponse)
@@ -263,6 +341,196 @@ func (c *contentServiceClient) SearchQuestionsAnswersUsers(ctx context.Context,
 	return out, nil
 }
 
+func (c *contentServiceClient) EditQuestion(ctx context.Context, in *EditQuestionRequest, opts ...grpc.CallOption) (*EditQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditQuestionResponse)
+	err := c.cc.Invoke(ctx, ContentService_EditQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) EditAnswer(ctx context.Context, in *EditAnswerRequest, opts ...grpc.CallOption) (*EditAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditAnswerResponse)
+	err := c.cc.Invoke(ctx, ContentService_EditAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) GetRevisions(ctx context.Context, in *GetRevisionsRequest, opts ...grpc.CallOption) (*GetRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevisionsResponse)
+	err := c.cc.Invoke(ctx, ContentService_GetRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) AcceptAnswer(ctx context.Context, in *AcceptAnswerRequest, opts ...grpc.CallOption) (*AcceptAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptAnswerResponse)
+	err := c.cc.Invoke(ctx, ContentService_AcceptAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) UnacceptAnswer(ctx context.Context, in *UnacceptAnswerRequest, opts ...grpc.CallOption) (*UnacceptAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnacceptAnswerResponse)
+	err := c.cc.Invoke(ctx, ContentService_UnacceptAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) PostComment(ctx context.Context, in *PostCommentRequest, opts ...grpc.CallOption) (*PostCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostCommentResponse)
+	err := c.cc.Invoke(ctx, ContentService_PostComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditCommentResponse)
+	err := c.cc.Invoke(ctx, ContentService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, ContentService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) FlagComment(ctx context.Context, in *FlagCommentRequest, opts ...grpc.CallOption) (*FlagCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlagCommentResponse)
+	err := c.cc.Invoke(ctx, ContentService_FlagComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowResponse)
+	err := c.cc.Invoke(ctx, ContentService_Follow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowResponse)
+	err := c.cc.Invoke(ctx, ContentService_Unfollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListFollows(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowsResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListFollows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) RestoreAnswer(ctx context.Context, in *RestoreAnswerRequest, opts ...grpc.CallOption) (*RestoreAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreAnswerResponse)
+	err := c.cc.Invoke(ctx, ContentService_RestoreAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) RestoreQuestion(ctx context.Context, in *RestoreQuestionRequest, opts ...grpc.CallOption) (*RestoreQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreQuestionResponse)
+	err := c.cc.Invoke(ctx, ContentService_RestoreQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationQueueResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ResolveFlag(ctx context.Context, in *ResolveFlagRequest, opts ...grpc.CallOption) (*ResolveFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveFlagResponse)
+	err := c.cc.Invoke(ctx, ContentService_ResolveFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) CountFlagsByReason(ctx context.Context, in *CountFlagsByReasonRequest, opts ...grpc.CallOption) (*CountFlagsByReasonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountFlagsByReasonResponse)
+	err := c.cc.Invoke(ctx, ContentService_CountFlagsByReason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ContentServiceServer is the server API for ContentService service.
 // All implementations must embed UnimplementedContentServiceServer
 // for forward compatibility.
@@ -279,13 +547,38 @@ type ContentServiceServer interface {
 	DownvoteAnswerByAnswerID(context.Context, *DownvoteAnswerByAnswerIDRequest) (*DownvoteAnswerByAnswerIDResponse, error)
 	FlagQuestion(context.Context, *FlagQuestionRequest) (*FlagQuestionResponse, error)
 	FlagAnswer(context.Context, *FlagAnswerRequest) (*FlagAnswerResponse, error)
+	// Deprecated: Do not use.
+	// Deprecated: use AcceptAnswer. This only confirms that the question has
+	// an accepted answer and fails with FAILED_PRECONDITION when it has none.
 	MarkQuestionAsAnswered(context.Context, *MarkQuestionAsAnsweredRequest) (*MarkQuestionAsAnsweredResponse, error)
 	GetFlaggedQuestions(context.Context, *GetFlaggedQuestionsRequest) (*GetFlaggedQuestionsResponse, error)
 	GetFlaggedAnswers(context.Context, *GetFlaggedAnswersRequest) (*GetFlaggedAnswersResponse, error)
 	GetUserFeed(context.Context, *GetUserFeedRequest) (*GetUserFeedResponse, error)
 	AddTag(context.Context, *AddTagRequest) (*AddTagResponse, error)
 	RemoveTag(context.Context, *RemoveTagRequest) (*RemoveTagResponse, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error)
+	GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
 	SearchQuestionsAnswersUsers(context.Context, *SearchRequest) (*SearchResponse, error)
+	EditQuestion(context.Context, *EditQuestionRequest) (*EditQuestionResponse, error)
+	EditAnswer(context.Context, *EditAnswerRequest) (*EditAnswerResponse, error)
+	GetRevisions(context.Context, *GetRevisionsRequest) (*GetRevisionsResponse, error)
+	AcceptAnswer(context.Context, *AcceptAnswerRequest) (*AcceptAnswerResponse, error)
+	UnacceptAnswer(context.Context, *UnacceptAnswerRequest) (*UnacceptAnswerResponse, error)
+	PostComment(context.Context, *PostCommentRequest) (*PostCommentResponse, error)
+	EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	FlagComment(context.Context, *FlagCommentRequest) (*FlagCommentResponse, error)
+	Follow(context.Context, *FollowRequest) (*FollowResponse, error)
+	Unfollow(context.Context, *UnfollowRequest) (*UnfollowResponse, error)
+	ListFollows(context.Context, *ListFollowsRequest) (*ListFollowsResponse, error)
+	RestoreAnswer(context.Context, *RestoreAnswerRequest) (*RestoreAnswerResponse, error)
+	RestoreQuestion(context.Context, *RestoreQuestionRequest) (*RestoreQuestionResponse, error)
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error)
+	ResolveFlag(context.Context, *ResolveFlagRequest) (*ResolveFlagResponse, error)
+	CountFlagsByReason(context.Context, *CountFlagsByReasonRequest) (*CountFlagsByReasonResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	mustEmbedUnimplementedContentServiceServer()
 }
 
@@ -350,9 +643,75 @@ func (UnimplementedContentServiceServer) AddTag(context.Context, *AddTagRequest)
 func (UnimplementedContentServiceServer) RemoveTag(context.Context, *RemoveTagRequest) (*RemoveTagResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTag not implemented")
 }
+func (UnimplementedContentServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedContentServiceServer) GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTag not implemented")
+}
+func (UnimplementedContentServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
 func (UnimplementedContentServiceServer) SearchQuestionsAnswersUsers(context.Context, *SearchRequest) (*SearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchQuestionsAnswersUsers not implemented")
 }
+func (UnimplementedContentServiceServer) EditQuestion(context.Context, *EditQuestionRequest) (*EditQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditQuestion not implemented")
+}
+func (UnimplementedContentServiceServer) EditAnswer(context.Context, *EditAnswerRequest) (*EditAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditAnswer not implemented")
+}
+func (UnimplementedContentServiceServer) GetRevisions(context.Context, *GetRevisionsRequest) (*GetRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevisions not implemented")
+}
+func (UnimplementedContentServiceServer) AcceptAnswer(context.Context, *AcceptAnswerRequest) (*AcceptAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAnswer not implemented")
+}
+func (UnimplementedContentServiceServer) UnacceptAnswer(context.Context, *UnacceptAnswerRequest) (*UnacceptAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnacceptAnswer not implemented")
+}
+func (UnimplementedContentServiceServer) PostComment(context.Context, *PostCommentRequest) (*PostCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostComment not implemented")
+}
+func (UnimplementedContentServiceServer) EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedContentServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedContentServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedContentServiceServer) FlagComment(context.Context, *FlagCommentRequest) (*FlagCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagComment not implemented")
+}
+func (UnimplementedContentServiceServer) Follow(context.Context, *FollowRequest) (*FollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (UnimplementedContentServiceServer) Unfollow(context.Context, *UnfollowRequest) (*UnfollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfollow not implemented")
+}
+func (UnimplementedContentServiceServer) ListFollows(context.Context, *ListFollowsRequest) (*ListFollowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollows not implemented")
+}
+func (UnimplementedContentServiceServer) RestoreAnswer(context.Context, *RestoreAnswerRequest) (*RestoreAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAnswer not implemented")
+}
+func (UnimplementedContentServiceServer) RestoreQuestion(context.Context, *RestoreQuestionRequest) (*RestoreQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreQuestion not implemented")
+}
+func (UnimplementedContentServiceServer) ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedContentServiceServer) ResolveFlag(context.Context, *ResolveFlagRequest) (*ResolveFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveFlag not implemented")
+}
+func (UnimplementedContentServiceServer) CountFlagsByReason(context.Context, *CountFlagsByReasonRequest) (*CountFlagsByReasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountFlagsByReason not implemented")
+}
+func (UnimplementedContentServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedContentServiceServer) mustEmbedUnimplementedContentServiceServer() {}
 func (UnimplementedContentServiceServer) testEmbeddedByValue()                        {}
 
@@ -698,6 +1057,60 @@ func _ContentService_RemoveTag_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContentService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetTag(ctx, req.(*GetTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ContentService_SearchQuestionsAnswersUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchRequest)
 	if err := dec(in); err != nil {
@@ -716,6 +1129,348 @@ func _ContentService_SearchQuestionsAnswersUsers_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContentService_EditQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).EditQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_EditQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).EditQuestion(ctx, req.(*EditQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_EditAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).EditAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_EditAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).EditAnswer(ctx, req.(*EditAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_GetRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetRevisions(ctx, req.(*GetRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_AcceptAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).AcceptAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_AcceptAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).AcceptAnswer(ctx, req.(*AcceptAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_UnacceptAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnacceptAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).UnacceptAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_UnacceptAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).UnacceptAnswer(ctx, req.(*UnacceptAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_PostComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).PostComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_PostComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).PostComment(ctx, req.(*PostCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_FlagComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).FlagComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_FlagComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).FlagComment(ctx, req.(*FlagCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_Follow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).Follow(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_Unfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).Unfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_Unfollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).Unfollow(ctx, req.(*UnfollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListFollows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListFollows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListFollows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListFollows(ctx, req.(*ListFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_RestoreAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).RestoreAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_RestoreAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).RestoreAnswer(ctx, req.(*RestoreAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_RestoreQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).RestoreQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_RestoreQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).RestoreQuestion(ctx, req.(*RestoreQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ResolveFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ResolveFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ResolveFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ResolveFlag(ctx, req.(*ResolveFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_CountFlagsByReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountFlagsByReasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).CountFlagsByReason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_CountFlagsByReason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).CountFlagsByReason(ctx, req.(*CountFlagsByReasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ContentService_ServiceDesc is the grpc.ServiceDesc for ContentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -795,10 +1550,98 @@ var ContentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTag",
 			Handler:    _ContentService_RemoveTag_Handler,
 		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _ContentService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "GetTag",
+			Handler:    _ContentService_GetTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _ContentService_ListTags_Handler,
+		},
 		{
 			MethodName: "SearchQuestionsAnswersUsers",
 			Handler:    _ContentService_SearchQuestionsAnswersUsers_Handler,
 		},
+		{
+			MethodName: "EditQuestion",
+			Handler:    _ContentService_EditQuestion_Handler,
+		},
+		{
+			MethodName: "EditAnswer",
+			Handler:    _ContentService_EditAnswer_Handler,
+		},
+		{
+			MethodName: "GetRevisions",
+			Handler:    _ContentService_GetRevisions_Handler,
+		},
+		{
+			MethodName: "AcceptAnswer",
+			Handler:    _ContentService_AcceptAnswer_Handler,
+		},
+		{
+			MethodName: "UnacceptAnswer",
+			Handler:    _ContentService_UnacceptAnswer_Handler,
+		},
+		{
+			MethodName: "PostComment",
+			Handler:    _ContentService_PostComment_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _ContentService_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _ContentService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _ContentService_ListComments_Handler,
+		},
+		{
+			MethodName: "FlagComment",
+			Handler:    _ContentService_FlagComment_Handler,
+		},
+		{
+			MethodName: "Follow",
+			Handler:    _ContentService_Follow_Handler,
+		},
+		{
+			MethodName: "Unfollow",
+			Handler:    _ContentService_Unfollow_Handler,
+		},
+		{
+			MethodName: "ListFollows",
+			Handler:    _ContentService_ListFollows_Handler,
+		},
+		{
+			MethodName: "RestoreAnswer",
+			Handler:    _ContentService_RestoreAnswer_Handler,
+		},
+		{
+			MethodName: "RestoreQuestion",
+			Handler:    _ContentService_RestoreQuestion_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _ContentService_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "ResolveFlag",
+			Handler:    _ContentService_ResolveFlag_Handler,
+		},
+		{
+			MethodName: "CountFlagsByReason",
+			Handler:    _ContentService_CountFlagsByReason_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _ContentService_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "content/content.proto",